@@ -0,0 +1,180 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	seperatechaining "github.com/OladapoAjala/datastructures/hashtables/seperate_chaining"
+	"github.com/OladapoAjala/datastructures/sequences/node"
+)
+
+var (
+	ErrNotFound      = errors.New("not found in cache")
+	ErrInvalidConfig = errors.New("invalid cache config")
+	ErrInvalidCost   = errors.New("entry cost must be positive")
+	ErrTooCostly     = errors.New("entry cost exceeds cache capacity")
+)
+
+// Cacher is implemented by LRU, LFU and TTL. None of them is safe for
+// concurrent use.
+type Cacher[K comparable, V any] interface {
+	Get(K) (V, error)
+	Put(K, V) error
+	PutWithCost(K, V, int64) error
+	Delete(K) error
+	GetSize() int32
+	GetCost() int64
+	GetCapacity() int64
+	Stats() Stats
+}
+
+type EvictReason int
+
+const (
+	// EvictCapacity means the entry was removed to make room for another.
+	EvictCapacity EvictReason = iota
+	// EvictExpired means the entry outlived its time to live.
+	EvictExpired
+)
+
+// Config is shared by the caches. Capacity limits the total cost of the
+// entries; Put gives each entry a cost of 1, so by default it limits their
+// number. OnEvict is called for entries removed by the cache itself, not for
+// Delete or for overwritten values. Clock and Hasher are optional.
+type Config[K comparable, V any] struct {
+	Capacity int64
+	OnEvict  func(key K, value V, reason EvictReason)
+	Clock    Clock
+	Hasher   hashtables.Hasher[K]
+}
+
+type Stats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64
+	Expirations uint64
+}
+
+// HitRatio is the fraction of lookups that were hits, or 0 before the first
+// lookup.
+func (s Stats) HitRatio() float64 {
+	lookups := s.Hits + s.Misses
+	if lookups == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(lookups)
+}
+
+// Clock is the time source of a TTL cache. Tests inject a manual clock to
+// make expiry deterministic.
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+var RealClock Clock = realClock{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	cost    int64
+	expires time.Time
+	freq    uint64
+	bucket  *node.Node[*bucket[K, V]]
+}
+
+// base holds what the caches share: the index from keys to list nodes, the
+// cost accounting and the statistics.
+type base[K comparable, V any] struct {
+	items    *seperatechaining.HashTable[K, *node.Node[*entry[K, V]]]
+	capacity int64
+	cost     int64
+	onEvict  func(K, V, EvictReason)
+	stats    Stats
+}
+
+const DEFAULT_CAPACITY = 16
+
+func newBase[K comparable, V any](config Config[K, V]) (base[K, V], error) {
+	if config.Capacity <= 0 {
+		return base[K, V]{}, ErrInvalidConfig
+	}
+	if config.Hasher == nil {
+		config.Hasher = hashtables.FNVHasher[K]{}
+	}
+
+	return base[K, V]{
+		items:    seperatechaining.NewHashTableWithHasher[K, *node.Node[*entry[K, V]]](DEFAULT_CAPACITY, config.Hasher),
+		capacity: config.Capacity,
+		onEvict:  config.OnEvict,
+	}, nil
+}
+
+func (b *base[K, V]) check(key K, cost int64) error {
+	switch {
+	case key == *new(K):
+		return hashtables.ErrInvalidKey
+	case cost <= 0:
+		return ErrInvalidCost
+	case cost > b.capacity:
+		return fmt.Errorf("cost %d: %w", cost, ErrTooCostly)
+	}
+	return nil
+}
+
+// lookup returns the node for key and records a hit or a miss.
+func (b *base[K, V]) lookup(key K) (*node.Node[*entry[K, V]], error) {
+	n, err := b.items.Find(key)
+	if err != nil {
+		b.stats.Misses++
+		return nil, fmt.Errorf("key %v %w", key, ErrNotFound)
+	}
+	b.stats.Hits++
+	return n, nil
+}
+
+// removed drops e from the index after its node has been unlinked, and
+// reports it to OnEvict unless it was deleted by the caller.
+func (b *base[K, V]) removed(e *entry[K, V], reason EvictReason, evicted bool) error {
+	if err := b.items.Delete(e.key); err != nil {
+		return err
+	}
+	b.cost -= e.cost
+	if !evicted {
+		return nil
+	}
+
+	switch reason {
+	case EvictCapacity:
+		b.stats.Evictions++
+	case EvictExpired:
+		b.stats.Expirations++
+	}
+	if b.onEvict != nil {
+		b.onEvict(e.key, e.value, reason)
+	}
+	return nil
+}
+
+func (b *base[K, V]) GetSize() int32 {
+	return b.items.GetSize()
+}
+
+func (b *base[K, V]) GetCost() int64 {
+	return b.cost
+}
+
+func (b *base[K, V]) GetCapacity() int64 {
+	return b.capacity
+}
+
+func (b *base[K, V]) Stats() Stats {
+	return b.stats
+}
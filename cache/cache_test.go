@@ -0,0 +1,116 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/stretchr/testify/assert"
+)
+
+type manualClock struct {
+	now time.Time
+}
+
+func newManualClock() *manualClock {
+	return &manualClock{now: time.Unix(1_000_000, 0)}
+}
+
+func (c *manualClock) Now() time.Time {
+	return c.now
+}
+
+func (c *manualClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+type eviction struct {
+	key    string
+	value  int
+	reason EvictReason
+}
+
+// recorder collects OnEvict calls.
+type recorder struct {
+	evicted []eviction
+}
+
+func (r *recorder) onEvict(key string, value int, reason EvictReason) {
+	r.evicted = append(r.evicted, eviction{key, value, reason})
+}
+
+func newCachers(t *testing.T, capacity int64, r *recorder) map[string]Cacher[string, int] {
+	config := Config[string, int]{Capacity: capacity, OnEvict: r.onEvict, Clock: newManualClock()}
+	lru, err := NewLRU(config)
+	assert.Nil(t, err)
+	lfu, err := NewLFU(config)
+	assert.Nil(t, err)
+	ttl, err := NewTTL(time.Minute, config)
+	assert.Nil(t, err)
+
+	return map[string]Cacher[string, int]{"lru": lru, "lfu": lfu, "ttl": ttl}
+}
+
+func Test_Cachers(t *testing.T) {
+	is := assert.New(t)
+
+	for name := range newCachers(t, 1, new(recorder)) {
+		t.Run(name, func(t *testing.T) {
+			r := new(recorder)
+			c := newCachers(t, 4, r)[name]
+
+			is.ErrorIs(c.Put("", 1), hashtables.ErrInvalidKey)
+			is.ErrorIs(c.PutWithCost("a", 1, 0), ErrInvalidCost)
+			is.ErrorIs(c.PutWithCost("a", 1, 5), ErrTooCostly)
+
+			_, err := c.Get("a")
+			is.ErrorIs(err, ErrNotFound)
+			is.ErrorIs(c.Delete("a"), ErrNotFound)
+
+			is.Nil(c.Put("a", 1))
+			is.Nil(c.PutWithCost("b", 2, 3))
+			is.EqualValues(2, c.GetSize())
+			is.EqualValues(4, c.GetCost())
+			is.EqualValues(4, c.GetCapacity())
+
+			is.Nil(c.Put("a", 10))
+			v, err := c.Get("a")
+			is.Nil(err)
+			is.Equal(10, v)
+			is.EqualValues(4, c.GetCost())
+
+			is.Nil(c.Delete("a"))
+			is.EqualValues(1, c.GetSize())
+			is.EqualValues(3, c.GetCost())
+			is.Empty(r.evicted)
+
+			// Filling the whole capacity evicts everything else.
+			is.Nil(c.PutWithCost("c", 3, 4))
+			is.Equal([]eviction{{"b", 2, EvictCapacity}}, r.evicted)
+			is.EqualValues(1, c.GetSize())
+			is.EqualValues(4, c.GetCost())
+
+			is.Equal(Stats{Hits: 1, Misses: 1, Evictions: 1}, c.Stats())
+			is.Equal(0.5, c.Stats().HitRatio())
+		})
+	}
+}
+
+func Test_Config(t *testing.T) {
+	is := assert.New(t)
+
+	_, err := NewLRU(Config[string, int]{})
+	is.ErrorIs(err, ErrInvalidConfig)
+	_, err = NewLFU(Config[string, int]{Capacity: -1})
+	is.ErrorIs(err, ErrInvalidConfig)
+	_, err = NewTTL(0, Config[string, int]{Capacity: 1})
+	is.ErrorIs(err, ErrInvalidConfig)
+
+	c, err := NewLRU(Config[string, int]{Capacity: 2, Hasher: hashtables.NewSipHasher[string](1, 2)})
+	is.Nil(err)
+	is.Nil(c.Put("a", 1))
+	v, err := c.Get("a")
+	is.Nil(err)
+	is.Equal(1, v)
+	is.Zero(Stats{}.HitRatio())
+}
@@ -0,0 +1,171 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/OladapoAjala/datastructures/sequences/linkedlist"
+	"github.com/OladapoAjala/datastructures/sequences/node"
+)
+
+// LFU evicts the least frequently used entries, and the least recently used
+// among equally frequent ones. Every operation is O(1): buckets holds one
+// bucket per access count in ascending order, and each bucket lists its
+// entries from the most to the least recently used.
+type LFU[K comparable, V any] struct {
+	base[K, V]
+	buckets *linkedlist.LinkedList[*bucket[K, V]]
+}
+
+type bucket[K comparable, V any] struct {
+	freq    uint64
+	entries *linkedlist.LinkedList[*entry[K, V]]
+}
+
+var _ Cacher[string, any] = new(LFU[string, any])
+
+func NewLFU[K comparable, V any](config Config[K, V]) (*LFU[K, V], error) {
+	b, err := newBase(config)
+	if err != nil {
+		return nil, err
+	}
+	return &LFU[K, V]{
+		base:    b,
+		buckets: linkedlist.NewList[*bucket[K, V]](),
+	}, nil
+}
+
+func newBucket[K comparable, V any](freq uint64) *bucket[K, V] {
+	return &bucket[K, V]{
+		freq:    freq,
+		entries: linkedlist.NewList[*entry[K, V]](),
+	}
+}
+
+func (c *LFU[K, V]) Get(key K) (V, error) {
+	n, err := c.lookup(key)
+	if err != nil {
+		return *new(V), err
+	}
+	if _, err := c.touch(n); err != nil {
+		return *new(V), err
+	}
+	return n.Data.value, nil
+}
+
+func (c *LFU[K, V]) Put(key K, value V) error {
+	return c.PutWithCost(key, value, 1)
+}
+
+// PutWithCost counts as a use of an existing key. A new key starts with a
+// count of one, so it is the first candidate for eviction after the entries
+// already at that count.
+func (c *LFU[K, V]) PutWithCost(key K, value V, cost int64) error {
+	if err := c.check(key, cost); err != nil {
+		return err
+	}
+
+	var e *entry[K, V]
+	if n, err := c.items.Find(key); err == nil {
+		c.cost += cost - n.Data.cost
+		n.Data.value, n.Data.cost = value, cost
+		if _, err := c.touch(n); err != nil {
+			return err
+		}
+		e = n.Data
+	} else {
+		head := c.buckets.Head
+		if head == nil || head.Data.freq != 1 {
+			if err := c.buckets.InsertFirst(newBucket[K, V](1)); err != nil {
+				return err
+			}
+			head = c.buckets.Head
+		}
+
+		e = &entry[K, V]{key: key, value: value, cost: cost, freq: 1, bucket: head}
+		if err := head.Data.entries.InsertFirst(e); err != nil {
+			return err
+		}
+		if err := c.items.Insert(key, head.Data.entries.Head); err != nil {
+			return err
+		}
+		c.cost += cost
+	}
+
+	for c.cost > c.capacity {
+		if err := c.evict(c.victim(e)); err != nil {
+			return fmt.Errorf("error evicting entry %w", err)
+		}
+	}
+	return nil
+}
+
+func (c *LFU[K, V]) Delete(key K) error {
+	n, err := c.items.Find(key)
+	if err != nil {
+		return fmt.Errorf("key %v %w", key, ErrNotFound)
+	}
+	if err := c.unlink(n); err != nil {
+		return err
+	}
+	return c.removed(n.Data, EvictCapacity, false)
+}
+
+// touch moves the entry of n to the bucket for its next access count and
+// returns its new node.
+func (c *LFU[K, V]) touch(n *node.Node[*entry[K, V]]) (*node.Node[*entry[K, V]], error) {
+	e := n.Data
+	cur := e.bucket
+	next := cur.Next
+	if next == nil || next.Data.freq != e.freq+1 {
+		if err := c.buckets.InsertAfter(cur, newBucket[K, V](e.freq+1)); err != nil {
+			return nil, err
+		}
+		next = cur.Next
+	}
+
+	if err := c.unlink(n); err != nil {
+		return nil, err
+	}
+	if err := next.Data.entries.InsertFirst(e); err != nil {
+		return nil, err
+	}
+	e.freq++
+	e.bucket = next
+	moved := next.Data.entries.Head
+	return moved, c.items.Update(e.key, moved)
+}
+
+// unlink removes n from its bucket, and the bucket once it is empty.
+func (c *LFU[K, V]) unlink(n *node.Node[*entry[K, V]]) error {
+	b := n.Data.bucket
+	if err := b.Data.entries.DeleteNode(n); err != nil {
+		return err
+	}
+	if b.Data.entries.IsEmpty() {
+		return c.buckets.DeleteNode(b)
+	}
+	return nil
+}
+
+// victim is the least recently used entry of the lowest count, passing over
+// the entry that is being written.
+func (c *LFU[K, V]) victim(keep *entry[K, V]) *node.Node[*entry[K, V]] {
+	for b := c.buckets.Head; b != nil; b = b.Next {
+		for n := b.Data.entries.Tail; n != nil; n = n.Prev {
+			if n.Data != keep {
+				return n
+			}
+		}
+	}
+	return nil
+}
+
+func (c *LFU[K, V]) evict(n *node.Node[*entry[K, V]]) error {
+	if n == nil {
+		return fmt.Errorf("no entry to evict")
+	}
+	if err := c.unlink(n); err != nil {
+		return err
+	}
+	return c.removed(n.Data, EvictCapacity, true)
+}
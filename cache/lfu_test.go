@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LFU(t *testing.T) {
+	is := assert.New(t)
+	r := new(recorder)
+	c, err := NewLFU(Config[string, int]{Capacity: 3, OnEvict: r.onEvict})
+	is.Nil(err)
+
+	get := func(key string, times int) {
+		for i := 0; i < times; i++ {
+			_, err := c.Get(key)
+			is.Nil(err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		action  func()
+		evicted []eviction
+		freqs   map[string]uint64
+	}{
+		{
+			name: "fill to capacity",
+			action: func() {
+				is.Nil(c.Put("a", 1))
+				is.Nil(c.Put("b", 2))
+				is.Nil(c.Put("c", 3))
+				get("a", 2)
+				get("b", 1)
+			},
+			freqs: map[string]uint64{"a": 3, "b": 2, "c": 1},
+		},
+		{
+			name: "evict least frequently used",
+			action: func() {
+				is.Nil(c.Put("d", 4))
+			},
+			evicted: []eviction{{"c", 3, EvictCapacity}},
+			freqs:   map[string]uint64{"a": 3, "b": 2, "d": 1},
+		},
+		{
+			name: "new entry is not evicted by its own insert",
+			action: func() {
+				is.Nil(c.Put("e", 5))
+			},
+			evicted: []eviction{{"c", 3, EvictCapacity}, {"d", 4, EvictCapacity}},
+			freqs:   map[string]uint64{"a": 3, "b": 2, "e": 1},
+		},
+		{
+			name: "ties evict the least recently used",
+			action: func() {
+				get("e", 1)
+				is.Nil(c.Put("f", 6))
+				get("f", 1)
+				is.Nil(c.Put("g", 7))
+			},
+			evicted: []eviction{
+				{"c", 3, EvictCapacity}, {"d", 4, EvictCapacity},
+				{"b", 2, EvictCapacity}, {"e", 5, EvictCapacity},
+			},
+			freqs: map[string]uint64{"a": 3, "f": 2, "g": 1},
+		},
+		{
+			name: "overwrite counts as a use",
+			action: func() {
+				is.Nil(c.Put("g", 70))
+				is.Nil(c.Put("g", 700))
+				is.Nil(c.PutWithCost("h", 8, 2))
+			},
+			evicted: []eviction{
+				{"c", 3, EvictCapacity}, {"d", 4, EvictCapacity},
+				{"b", 2, EvictCapacity}, {"e", 5, EvictCapacity},
+				{"f", 6, EvictCapacity}, {"a", 1, EvictCapacity},
+			},
+			freqs: map[string]uint64{"g": 3, "h": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.action()
+			is.Equal(tt.evicted, r.evicted)
+			is.Equal(tt.freqs, lfuFreqs(is, c))
+		})
+	}
+}
+
+// lfuFreqs also checks that the buckets are ascending and non-empty.
+func lfuFreqs(is *assert.Assertions, c *LFU[string, int]) map[string]uint64 {
+	freqs := map[string]uint64{}
+	last := uint64(0)
+	for b := c.buckets.Head; b != nil; b = b.Next {
+		is.Greater(b.Data.freq, last)
+		is.False(b.Data.entries.IsEmpty())
+		last = b.Data.freq
+		for n := b.Data.entries.Head; n != nil; n = n.Next {
+			is.Equal(b.Data.freq, n.Data.freq)
+			freqs[n.Data.key] = n.Data.freq
+		}
+	}
+	is.EqualValues(len(freqs), c.GetSize())
+	return freqs
+}
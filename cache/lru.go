@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/OladapoAjala/datastructures/sequences/linkedlist"
+	"github.com/OladapoAjala/datastructures/sequences/node"
+)
+
+// LRU evicts the least recently used entries. order runs from the most
+// recently used entry at the head to the least at the tail.
+type LRU[K comparable, V any] struct {
+	base[K, V]
+	order *linkedlist.LinkedList[*entry[K, V]]
+}
+
+var _ Cacher[string, any] = new(LRU[string, any])
+
+func NewLRU[K comparable, V any](config Config[K, V]) (*LRU[K, V], error) {
+	b, err := newBase(config)
+	if err != nil {
+		return nil, err
+	}
+	return &LRU[K, V]{
+		base:  b,
+		order: linkedlist.NewList[*entry[K, V]](),
+	}, nil
+}
+
+func (c *LRU[K, V]) Get(key K) (V, error) {
+	n, err := c.lookup(key)
+	if err != nil {
+		return *new(V), err
+	}
+	if err := c.order.MoveFirst(n); err != nil {
+		return *new(V), err
+	}
+	return n.Data.value, nil
+}
+
+func (c *LRU[K, V]) Put(key K, value V) error {
+	return c.PutWithCost(key, value, 1)
+}
+
+func (c *LRU[K, V]) PutWithCost(key K, value V, cost int64) error {
+	if err := c.check(key, cost); err != nil {
+		return err
+	}
+
+	if n, err := c.items.Find(key); err == nil {
+		c.cost += cost - n.Data.cost
+		n.Data.value, n.Data.cost = value, cost
+		if err := c.order.MoveFirst(n); err != nil {
+			return err
+		}
+	} else {
+		if err := c.order.InsertFirst(&entry[K, V]{key: key, value: value, cost: cost}); err != nil {
+			return err
+		}
+		if err := c.items.Insert(key, c.order.Head); err != nil {
+			return err
+		}
+		c.cost += cost
+	}
+
+	// The entry just written is at the head, and its cost fits on its own,
+	// so eviction stops before reaching it.
+	for c.cost > c.capacity {
+		if err := c.evict(c.order.Tail, EvictCapacity); err != nil {
+			return fmt.Errorf("error evicting entry %w", err)
+		}
+	}
+	return nil
+}
+
+func (c *LRU[K, V]) Delete(key K) error {
+	n, err := c.items.Find(key)
+	if err != nil {
+		return fmt.Errorf("key %v %w", key, ErrNotFound)
+	}
+	if err := c.order.DeleteNode(n); err != nil {
+		return err
+	}
+	return c.removed(n.Data, EvictCapacity, false)
+}
+
+func (c *LRU[K, V]) evict(n *node.Node[*entry[K, V]], reason EvictReason) error {
+	if err := c.order.DeleteNode(n); err != nil {
+		return err
+	}
+	return c.removed(n.Data, reason, true)
+}
@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LRU(t *testing.T) {
+	is := assert.New(t)
+	r := new(recorder)
+	c, err := NewLRU(Config[string, int]{Capacity: 3, OnEvict: r.onEvict})
+	is.Nil(err)
+
+	tests := []struct {
+		name    string
+		action  func()
+		evicted []eviction
+		keys    []string
+	}{
+		{
+			name: "fill to capacity",
+			action: func() {
+				is.Nil(c.Put("a", 1))
+				is.Nil(c.Put("b", 2))
+				is.Nil(c.Put("c", 3))
+			},
+			keys: []string{"c", "b", "a"},
+		},
+		{
+			name: "evict least recently put",
+			action: func() {
+				is.Nil(c.Put("d", 4))
+			},
+			evicted: []eviction{{"a", 1, EvictCapacity}},
+			keys:    []string{"d", "c", "b"},
+		},
+		{
+			name: "get refreshes recency",
+			action: func() {
+				_, err := c.Get("b")
+				is.Nil(err)
+				is.Nil(c.Put("e", 5))
+			},
+			evicted: []eviction{{"a", 1, EvictCapacity}, {"c", 3, EvictCapacity}},
+			keys:    []string{"e", "b", "d"},
+		},
+		{
+			name: "overwrite refreshes recency",
+			action: func() {
+				is.Nil(c.Put("d", 40))
+			},
+			evicted: []eviction{{"a", 1, EvictCapacity}, {"c", 3, EvictCapacity}},
+			keys:    []string{"d", "e", "b"},
+		},
+		{
+			name: "costly entry evicts several",
+			action: func() {
+				is.Nil(c.PutWithCost("f", 6, 2))
+			},
+			evicted: []eviction{
+				{"a", 1, EvictCapacity}, {"c", 3, EvictCapacity},
+				{"b", 2, EvictCapacity}, {"e", 5, EvictCapacity},
+			},
+			keys: []string{"f", "d"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.action()
+			is.Equal(tt.evicted, r.evicted)
+			is.Equal(tt.keys, lruKeys(c))
+			is.EqualValues(len(tt.keys), c.GetSize())
+		})
+	}
+}
+
+func lruKeys(c *LRU[string, int]) []string {
+	keys := []string{}
+	for n := c.order.Head; n != nil; n = n.Next {
+		keys = append(keys, n.Data.key)
+	}
+	return keys
+}
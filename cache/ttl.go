@@ -0,0 +1,133 @@
+package cache
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/OladapoAjala/datastructures/sequences/linkedlist"
+	"github.com/OladapoAjala/datastructures/sequences/node"
+)
+
+// TTL expires entries a fixed time after they were last written. When it is
+// full it evicts the entries closest to expiry. order runs from the most
+// recently written entry at the head to the oldest at the tail, which is
+// also expiry order. Expired entries are dropped lazily by Get and Put, or
+// eagerly by Expire; until then they count towards GetSize and GetCost.
+type TTL[K comparable, V any] struct {
+	base[K, V]
+	order *linkedlist.LinkedList[*entry[K, V]]
+	ttl   time.Duration
+	clock Clock
+}
+
+var _ Cacher[string, any] = new(TTL[string, any])
+
+func NewTTL[K comparable, V any](ttl time.Duration, config Config[K, V]) (*TTL[K, V], error) {
+	if ttl <= 0 {
+		return nil, ErrInvalidConfig
+	}
+	b, err := newBase(config)
+	if err != nil {
+		return nil, err
+	}
+	if config.Clock == nil {
+		config.Clock = RealClock
+	}
+
+	return &TTL[K, V]{
+		base:  b,
+		order: linkedlist.NewList[*entry[K, V]](),
+		ttl:   ttl,
+		clock: config.Clock,
+	}, nil
+}
+
+// Get reports an expired entry as a miss and evicts it.
+func (c *TTL[K, V]) Get(key K) (V, error) {
+	n, err := c.items.Find(key)
+	if err == nil && c.expired(n.Data, c.clock.Now()) {
+		if err := c.evict(n, EvictExpired); err != nil {
+			return *new(V), err
+		}
+	}
+
+	n, err = c.lookup(key)
+	if err != nil {
+		return *new(V), err
+	}
+	return n.Data.value, nil
+}
+
+func (c *TTL[K, V]) Put(key K, value V) error {
+	return c.PutWithCost(key, value, 1)
+}
+
+// PutWithCost restarts the entry's time to live.
+func (c *TTL[K, V]) PutWithCost(key K, value V, cost int64) error {
+	if err := c.check(key, cost); err != nil {
+		return err
+	}
+	if _, err := c.Expire(); err != nil {
+		return err
+	}
+
+	expires := c.clock.Now().Add(c.ttl)
+	if n, err := c.items.Find(key); err == nil {
+		c.cost += cost - n.Data.cost
+		n.Data.value, n.Data.cost, n.Data.expires = value, cost, expires
+		if err := c.order.MoveFirst(n); err != nil {
+			return err
+		}
+	} else {
+		e := &entry[K, V]{key: key, value: value, cost: cost, expires: expires}
+		if err := c.order.InsertFirst(e); err != nil {
+			return err
+		}
+		if err := c.items.Insert(key, c.order.Head); err != nil {
+			return err
+		}
+		c.cost += cost
+	}
+
+	for c.cost > c.capacity {
+		if err := c.evict(c.order.Tail, EvictCapacity); err != nil {
+			return fmt.Errorf("error evicting entry %w", err)
+		}
+	}
+	return nil
+}
+
+func (c *TTL[K, V]) Delete(key K) error {
+	n, err := c.items.Find(key)
+	if err != nil {
+		return fmt.Errorf("key %v %w", key, ErrNotFound)
+	}
+	if err := c.order.DeleteNode(n); err != nil {
+		return err
+	}
+	return c.removed(n.Data, EvictExpired, false)
+}
+
+// Expire evicts every expired entry and returns how many there were.
+func (c *TTL[K, V]) Expire() (int, error) {
+	now := c.clock.Now()
+	count := 0
+	for n := c.order.Tail; n != nil && c.expired(n.Data, now); n = c.order.Tail {
+		if err := c.evict(n, EvictExpired); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+func (c *TTL[K, V]) expired(e *entry[K, V], now time.Time) bool {
+	return !now.Before(e.expires)
+}
+
+func (c *TTL[K, V]) evict(n *node.Node[*entry[K, V]], reason EvictReason) error {
+	if err := c.order.DeleteNode(n); err != nil {
+		return err
+	}
+	return c.removed(n.Data, reason, true)
+}
@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TTL(t *testing.T) {
+	is := assert.New(t)
+	clock := newManualClock()
+	r := new(recorder)
+	c, err := NewTTL(10*time.Second, Config[string, int]{Capacity: 3, OnEvict: r.onEvict, Clock: clock})
+	is.Nil(err)
+
+	tests := []struct {
+		name    string
+		action  func()
+		evicted []eviction
+		size    int32
+	}{
+		{
+			name: "entries live until their ttl",
+			action: func() {
+				is.Nil(c.Put("a", 1))
+				clock.Advance(4 * time.Second)
+				is.Nil(c.Put("b", 2))
+				clock.Advance(5 * time.Second)
+				v, err := c.Get("a")
+				is.Nil(err)
+				is.Equal(1, v)
+			},
+			size: 2,
+		},
+		{
+			name: "get misses an expired entry",
+			action: func() {
+				clock.Advance(time.Second)
+				_, err := c.Get("a")
+				is.ErrorIs(err, ErrNotFound)
+			},
+			evicted: []eviction{{"a", 1, EvictExpired}},
+			size:    1,
+		},
+		{
+			name: "put restarts the ttl",
+			action: func() {
+				clock.Advance(3 * time.Second)
+				is.Nil(c.Put("b", 20))
+				clock.Advance(5 * time.Second)
+				v, err := c.Get("b")
+				is.Nil(err)
+				is.Equal(20, v)
+			},
+			evicted: []eviction{{"a", 1, EvictExpired}},
+			size:    1,
+		},
+		{
+			name: "full cache evicts the entry closest to expiry",
+			action: func() {
+				is.Nil(c.Put("c", 3))
+				is.Nil(c.Put("d", 4))
+				is.Nil(c.Put("e", 5))
+			},
+			evicted: []eviction{{"a", 1, EvictExpired}, {"b", 20, EvictCapacity}},
+			size:    3,
+		},
+		{
+			name: "expire removes every expired entry",
+			action: func() {
+				clock.Advance(10 * time.Second)
+				count, err := c.Expire()
+				is.Nil(err)
+				is.Equal(3, count)
+			},
+			evicted: []eviction{
+				{"a", 1, EvictExpired}, {"b", 20, EvictCapacity},
+				{"c", 3, EvictExpired}, {"d", 4, EvictExpired}, {"e", 5, EvictExpired},
+			},
+			size: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.action()
+			is.Equal(tt.evicted, r.evicted)
+			is.Equal(tt.size, c.GetSize())
+		})
+	}
+
+	is.Equal(Stats{Hits: 2, Misses: 1, Evictions: 1, Expirations: 4}, c.Stats())
+}
@@ -1,6 +1,6 @@
 module github.com/OladapoAjala/datastructures
 
-go 1.18
+go 1.23
 
 require (
 	github.com/Pallinder/go-randomdata v1.2.0
@@ -0,0 +1,207 @@
+package hashtables_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/OladapoAjala/datastructures/hashtables/cuckoo"
+	doublehashing "github.com/OladapoAjala/datastructures/hashtables/double_hashing"
+	linearprobing "github.com/OladapoAjala/datastructures/hashtables/linear_probing"
+	robinhood "github.com/OladapoAjala/datastructures/hashtables/robin_hood"
+	seperatechaining "github.com/OladapoAjala/datastructures/hashtables/seperate_chaining"
+)
+
+type table interface {
+	hashtables.HashTabler[int, int]
+	hashtables.Prober[int]
+}
+
+// capacity is prime so every table starts with the same number of slots.
+const capacity = 16381
+
+var tables = []struct {
+	name string
+	new  func(int32) table
+}{
+	{
+		name: "double_hashing",
+		new:  func(c int32) table { return doublehashing.NewHashTable[int, int](c) },
+	},
+	{
+		name: "seperate_chaining",
+		new:  func(c int32) table { return seperatechaining.NewHashTable[int, int](c) },
+	},
+	{
+		name: "linear_probing",
+		new:  func(c int32) table { return linearprobing.NewHashTable[int, int](c) },
+	},
+	{
+		name: "robin_hood",
+		new:  func(c int32) table { return robinhood.NewHashTable[int, int](c) },
+	},
+	{
+		name: "cuckoo",
+		new:  func(c int32) table { return cuckoo.NewHashTable[int, int](c) },
+	},
+	{
+		name: "cuckoo_bucketed",
+		new:  func(c int32) table { return cuckoo.NewBucketedHashTable[int, int](c, 4, nil) },
+	},
+}
+
+// loadFactors stay below every table's resize threshold, except that the
+// one-slot cuckoo table grows past half full.
+var loadFactors = []float64{0.25, 0.5, 0.65}
+
+func fill(b *testing.B, newTable func(int32) table, n int) table {
+	ht := newTable(capacity)
+	for key := 1; key <= n; key++ {
+		if err := ht.Insert(key, key); err != nil {
+			b.Fatal(err)
+		}
+	}
+	return ht
+}
+
+func forEachTable(b *testing.B, bench func(*testing.B, func(int32) table, int)) {
+	for _, tt := range tables {
+		for _, lf := range loadFactors {
+			n := int(lf * capacity)
+			b.Run(fmt.Sprintf("%s/load=%.2f", tt.name, lf), func(b *testing.B) {
+				bench(b, tt.new, n)
+			})
+		}
+	}
+}
+
+func Benchmark_ProbeLength(b *testing.B) {
+	forEachTable(b, func(b *testing.B, newTable func(int32) table, n int) {
+		var total, longest int32
+		for i := 0; i < b.N; i++ {
+			ht := fill(b, newTable, n)
+			total, longest = 0, 0
+			for key := 1; key <= n; key++ {
+				probes, err := ht.ProbeLength(key)
+				if err != nil {
+					b.Fatal(err)
+				}
+				total += probes
+				if probes > longest {
+					longest = probes
+				}
+			}
+		}
+		b.ReportMetric(float64(total)/float64(n), "probes/key")
+		b.ReportMetric(float64(longest), "max-probes")
+	})
+}
+
+func Benchmark_Insert(b *testing.B) {
+	forEachTable(b, func(b *testing.B, newTable func(int32) table, n int) {
+		for i := 0; i < b.N; i++ {
+			fill(b, newTable, n)
+		}
+		b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*n), "ns/insert")
+	})
+}
+
+func Benchmark_Find(b *testing.B) {
+	forEachTable(b, func(b *testing.B, newTable func(int32) table, n int) {
+		ht := fill(b, newTable, n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := ht.Find(i%n + 1); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func Benchmark_FindMissing(b *testing.B) {
+	forEachTable(b, func(b *testing.B, newTable func(int32) table, n int) {
+		ht := fill(b, newTable, n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = ht.Find(n + 1 + i%n)
+		}
+	})
+}
+
+// Benchmark_Churn deletes the oldest key and inserts a new one, keeping the
+// load factor constant while the set of keys moves through the table.
+func Benchmark_Churn(b *testing.B) {
+	forEachTable(b, func(b *testing.B, newTable func(int32) table, n int) {
+		ht := fill(b, newTable, n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if err := ht.Delete(i + 1); err != nil {
+				b.Fatal(err)
+			}
+			if err := ht.Insert(n+i+1, i); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// Benchmark_Grow inserts into tables created with a handful of slots, so
+// every run crosses several resize boundaries.
+func Benchmark_Grow(b *testing.B) {
+	for _, tt := range tables {
+		for _, n := range []int{capacity / 4, capacity, 4 * capacity} {
+			b.Run(fmt.Sprintf("%s/n=%d", tt.name, n), func(b *testing.B) {
+				var ht table
+				for i := 0; i < b.N; i++ {
+					ht = tt.new(7)
+					for key := 1; key <= n; key++ {
+						if err := ht.Insert(key, key); err != nil {
+							b.Fatal(err)
+						}
+					}
+				}
+				b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*n), "ns/insert")
+				b.ReportMetric(float64(ht.GetCapacity()), "final-capacity")
+			})
+		}
+	}
+}
+
+// Benchmark_ResizeLatency reports the slowest single insert while growing a
+// table from empty, with and without incremental resizing.
+func Benchmark_ResizeLatency(b *testing.B) {
+	const n = 1 << 13
+	newTables := map[string]func(hashtables.Config[int]) (table, error){
+		"double_hashing": func(c hashtables.Config[int]) (table, error) {
+			return doublehashing.NewHashTableWithConfig[int, int](7, c)
+		},
+		"seperate_chaining": func(c hashtables.Config[int]) (table, error) {
+			return seperatechaining.NewHashTableWithConfig[int, int](8, c)
+		},
+	}
+
+	for name, newTable := range newTables {
+		for _, incremental := range []bool{false, true} {
+			b.Run(fmt.Sprintf("%s/incremental=%t", name, incremental), func(b *testing.B) {
+				var longest time.Duration
+				for i := 0; i < b.N; i++ {
+					ht, err := newTable(hashtables.Config[int]{Incremental: incremental})
+					if err != nil {
+						b.Fatal(err)
+					}
+					for key := 1; key <= n; key++ {
+						start := time.Now()
+						if err := ht.Insert(key, key); err != nil {
+							b.Fatal(err)
+						}
+						if d := time.Since(start); d > longest {
+							longest = d
+						}
+					}
+				}
+				b.ReportMetric(float64(longest.Microseconds()), "max-µs/insert")
+			})
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package bimap
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	seperatechaining "github.com/OladapoAjala/datastructures/hashtables/seperate_chaining"
+)
+
+const DEFAULT_CAPACITY = 16
+
+var ErrValueExists = errors.New("value already mapped to another key")
+
+// BiMap is a one-to-one map that can be looked up by key or by value. Keys
+// and values are each unique, and like keys, the zero value is not a valid
+// value.
+type BiMap[K comparable, V comparable] struct {
+	forward *seperatechaining.HashTable[K, V]
+	inverse *seperatechaining.HashTable[V, K]
+}
+
+func NewBiMap[K comparable, V comparable](capacity int32) *BiMap[K, V] {
+	if capacity == 0 {
+		capacity = DEFAULT_CAPACITY
+	}
+	return &BiMap[K, V]{
+		forward: seperatechaining.NewHashTable[K, V](capacity),
+		inverse: seperatechaining.NewHashTable[V, K](capacity),
+	}
+}
+
+// Inverse returns a view of the map from values to keys. The view shares
+// the map's tables, so changes through either are seen by both.
+func (b *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return &BiMap[V, K]{
+		forward: b.inverse,
+		inverse: b.forward,
+	}
+}
+
+// Insert maps key to value, replacing any previous value of key. It returns
+// ErrValueExists if value is already mapped from a different key.
+func (b *BiMap[K, V]) Insert(key K, value V) error {
+	if err := b.check(key, value); err != nil {
+		return err
+	}
+	if other, err := b.inverse.Find(value); err == nil && other != key {
+		return fmt.Errorf("value %v: %w", value, ErrValueExists)
+	}
+	return b.put(key, value)
+}
+
+// ForceInsert maps key to value, first removing any pair that holds either.
+func (b *BiMap[K, V]) ForceInsert(key K, value V) error {
+	if err := b.check(key, value); err != nil {
+		return err
+	}
+	if other, err := b.inverse.Find(value); err == nil && other != key {
+		if err := b.forward.Delete(other); err != nil {
+			return err
+		}
+	}
+	return b.put(key, value)
+}
+
+func (b *BiMap[K, V]) check(key K, value V) error {
+	if key == *new(K) || value == *new(V) {
+		return hashtables.ErrInvalidKey
+	}
+	return nil
+}
+
+// put stores the pair once value is known to be free or already key's.
+func (b *BiMap[K, V]) put(key K, value V) error {
+	old, ok, err := b.forward.Upsert(key, value)
+	if err != nil {
+		return err
+	}
+	if ok && old != value {
+		if err := b.inverse.Delete(old); err != nil {
+			return err
+		}
+	}
+	return b.inverse.Insert(value, key)
+}
+
+func (b *BiMap[K, V]) Find(key K) (V, error) {
+	return b.forward.Find(key)
+}
+
+func (b *BiMap[K, V]) FindKey(value V) (K, error) {
+	return b.inverse.Find(value)
+}
+
+func (b *BiMap[K, V]) Delete(key K) error {
+	value, err := b.forward.Find(key)
+	if err != nil {
+		return err
+	}
+	if err := b.forward.Delete(key); err != nil {
+		return err
+	}
+	return b.inverse.Delete(value)
+}
+
+func (b *BiMap[K, V]) DeleteValue(value V) error {
+	return b.Inverse().Delete(value)
+}
+
+func (b *BiMap[K, V]) Range(f func(K, V) bool) {
+	b.forward.Range(f)
+}
+
+func (b *BiMap[K, V]) GetSize() int32 {
+	return b.forward.GetSize()
+}
@@ -0,0 +1,128 @@
+package bimap
+
+import (
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_BiMap(t *testing.T) {
+	is := assert.New(t)
+	b := NewBiMap[string, int](0)
+
+	tests := []struct {
+		name   string
+		action func()
+		want   map[string]int
+	}{
+		{
+			name: "insert pairs",
+			action: func() {
+				is.Nil(b.Insert("one", 1))
+				is.Nil(b.Insert("two", 2))
+			},
+			want: map[string]int{"one": 1, "two": 2},
+		},
+		{
+			name: "invalid key or value",
+			action: func() {
+				is.ErrorIs(b.Insert("", 3), hashtables.ErrInvalidKey)
+				is.ErrorIs(b.Insert("zero", 0), hashtables.ErrInvalidKey)
+			},
+			want: map[string]int{"one": 1, "two": 2},
+		},
+		{
+			name: "reinsert the same pair",
+			action: func() {
+				is.Nil(b.Insert("one", 1))
+			},
+			want: map[string]int{"one": 1, "two": 2},
+		},
+		{
+			name: "replacing a value frees the old one",
+			action: func() {
+				is.Nil(b.Insert("one", 11))
+				_, err := b.FindKey(1)
+				is.ErrorIs(err, hashtables.ErrNotFound)
+			},
+			want: map[string]int{"one": 11, "two": 2},
+		},
+		{
+			name: "value taken by another key",
+			action: func() {
+				is.ErrorIs(b.Insert("three", 2), ErrValueExists)
+			},
+			want: map[string]int{"one": 11, "two": 2},
+		},
+		{
+			name: "force insert takes the value over",
+			action: func() {
+				is.Nil(b.ForceInsert("three", 2))
+				_, err := b.Find("two")
+				is.ErrorIs(err, hashtables.ErrNotFound)
+			},
+			want: map[string]int{"one": 11, "three": 2},
+		},
+		{
+			name: "force insert replaces both sides",
+			action: func() {
+				is.Nil(b.ForceInsert("one", 2))
+				_, err := b.FindKey(11)
+				is.ErrorIs(err, hashtables.ErrNotFound)
+			},
+			want: map[string]int{"one": 2},
+		},
+		{
+			name: "delete by key and by value",
+			action: func() {
+				is.Nil(b.Insert("four", 4))
+				is.Nil(b.Insert("five", 5))
+				is.Nil(b.Delete("four"))
+				is.Nil(b.DeleteValue(2))
+				is.ErrorIs(b.Delete("four"), hashtables.ErrNotFound)
+				is.ErrorIs(b.DeleteValue(2), hashtables.ErrNotFound)
+			},
+			want: map[string]int{"five": 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.action()
+			is.EqualValues(len(tt.want), b.GetSize())
+			is.EqualValues(len(tt.want), b.Inverse().GetSize())
+			for key, value := range tt.want {
+				got, err := b.Find(key)
+				is.Nil(err)
+				is.Equal(value, got)
+				gotKey, err := b.FindKey(value)
+				is.Nil(err)
+				is.Equal(key, gotKey)
+			}
+		})
+	}
+}
+
+func Test_Inverse(t *testing.T) {
+	is := assert.New(t)
+	b := NewBiMap[string, int](0)
+	inv := b.Inverse()
+
+	is.Nil(inv.Insert(1, "one"))
+	key, err := b.FindKey(1)
+	is.Nil(err)
+	is.Equal("one", key)
+
+	is.Nil(b.Insert("two", 2))
+	value, err := inv.FindKey("two")
+	is.Nil(err)
+	is.Equal(2, value)
+
+	pairs := map[string]int{}
+	b.Range(func(k string, v int) bool {
+		pairs[k] = v
+		return true
+	})
+	is.Equal(map[string]int{"one": 1, "two": 2}, pairs)
+}
@@ -0,0 +1,365 @@
+package cuckoo
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/OladapoAjala/datastructures/helpers"
+	"github.com/OladapoAjala/datastructures/sets/data"
+)
+
+// HashTable is a cuckoo hash table. Every key has one bucket in each of two
+// tables: the first chosen by the hasher's Hash, the second by its Probe,
+// which is the same secondary hash double hashing steps with. A key lives
+// in one of those two buckets or in a small stash, so Find and Delete
+// inspect at most 2*slots+STASH_SIZE entries.
+//
+// An insert into two full buckets evicts a resident, which moves to its
+// other bucket and may evict in turn. A chain of evictions longer than
+// maxKicks is taken to be a cycle; the homeless entry goes to the stash,
+// and once the stash is full the table is rehashed at twice the size.
+// Rehashing with a fixed hasher cannot separate keys whose Hash and Probe
+// both collide, so such keys pile up in the stash instead.
+//
+// With one slot per bucket the table must stay under half full. Bucketed
+// tables, with several slots per bucket, run at over 90% load.
+type HashTable[K comparable, V any] struct {
+	Table      [2][]*data.Data[K, V]
+	stash      []*data.Data[K, V]
+	buckets    int32
+	slots      int32
+	size       int32
+	loadFactor float32
+	maxLoad    float32
+	maxKicks   int
+	rng        uint32
+	building   bool
+	hasher     hashtables.Hasher[K]
+}
+
+const (
+	DEFAULT_CAPACITY = 7
+	STASH_SIZE       = 4
+	MAX_REHASHES     = 3
+)
+
+// errOverflow aborts building a rehashed table that would need more than
+// STASH_SIZE stashed entries.
+var errOverflow = errors.New("cuckoo stash overflow")
+
+type HashTabler[K comparable, V any] interface {
+	hashtables.HashTabler[K, V]
+	hashtables.Prober[K]
+	GetLoadFactor() float32
+	GetStashSize() int32
+}
+
+var _ HashTabler[string, any] = new(HashTable[string, any])
+
+func NewHashTable[K comparable, V any](capacity int32) *HashTable[K, V] {
+	return NewBucketedHashTable[K, V](capacity, 1, hashtables.FNVHasher[K]{})
+}
+
+func NewHashTableWithHash[K comparable, V any](capacity int32, hash hashtables.HashFunc[K]) *HashTable[K, V] {
+	return NewBucketedHashTable[K, V](capacity, 1, hash)
+}
+
+func NewHashTableWithHasher[K comparable, V any](capacity int32, hasher hashtables.Hasher[K]) *HashTable[K, V] {
+	return NewBucketedHashTable[K, V](capacity, 1, hasher)
+}
+
+// NewBucketedHashTable creates a table whose buckets hold slots entries
+// each. capacity is the total number of slots across both tables.
+func NewBucketedHashTable[K comparable, V any](capacity, slots int32, hasher hashtables.Hasher[K]) *HashTable[K, V] {
+	if capacity == 0 {
+		capacity = DEFAULT_CAPACITY
+	}
+	if slots < 1 {
+		slots = 1
+	}
+	if hasher == nil {
+		hasher = hashtables.FNVHasher[K]{}
+	}
+
+	buckets := capacity / (2 * slots)
+	if !helpers.IsPrime(buckets) {
+		buckets = helpers.NextPrime(buckets)
+	}
+
+	var maxLoad float32
+	switch slots {
+	case 1:
+		maxLoad = 0.45
+	case 2:
+		maxLoad = 0.85
+	default:
+		maxLoad = 0.93
+	}
+
+	return &HashTable[K, V]{
+		Table: [2][]*data.Data[K, V]{
+			make([]*data.Data[K, V], buckets*slots),
+			make([]*data.Data[K, V], buckets*slots),
+		},
+		buckets:  buckets,
+		slots:    slots,
+		maxLoad:  maxLoad,
+		maxKicks: 8 * bits.Len32(uint32(buckets)),
+		rng:      2463534242,
+		hasher:   hasher,
+	}
+}
+
+func (h *HashTable[K, V]) Insert(key K, value V) error {
+	if key == *new(K) {
+		return hashtables.ErrInvalidKey
+	}
+	if item := h.entry(key); item != nil {
+		item.Value = value
+		return nil
+	}
+	return h.add(key, value)
+}
+
+// entry returns the stored item for key, or nil if key is absent.
+func (h *HashTable[K, V]) entry(key K) *data.Data[K, V] {
+	item, _ := h.lookup(data.NewHashedData(key, *new(V), h.hasher.Hash(key)))
+	return item
+}
+
+// add stores a key that is known to be absent.
+func (h *HashTable[K, V]) add(key K, value V) error {
+	homeless := h.place(data.NewHashedData(key, value, h.hasher.Hash(key)))
+	if homeless != nil && len(h.stash) < STASH_SIZE {
+		h.stash = append(h.stash, homeless)
+		homeless = nil
+	}
+	if homeless != nil {
+		if h.building {
+			return errOverflow
+		}
+		if h.rehash(helpers.NextPrime(2*h.buckets), homeless) {
+			return nil
+		}
+		// Only keys that collide on both hashes get here, since a larger
+		// table would separate any others. Keep the entry rather than lose
+		// it; lookups stay correct but slow down with the stash.
+		h.stash = append(h.stash, homeless)
+	}
+
+	h.size++
+	h.loadFactor = float32(h.size) / float32(h.GetCapacity())
+	if !h.building && h.loadFactor >= h.maxLoad {
+		h.rehash(helpers.NextPrime(2*h.buckets), nil)
+	}
+	return nil
+}
+
+// place puts item in a free slot of either of its buckets, evicting
+// residents along the way if both are full. It returns the entry left
+// without a slot when the chain of evictions runs into a cycle.
+func (h *HashTable[K, V]) place(item *data.Data[K, V]) *data.Data[K, V] {
+	for t := 0; t < 2; t++ {
+		if h.fill(t, item) {
+			return nil
+		}
+	}
+
+	t := 0
+	for kicks := 0; kicks < h.maxKicks; kicks++ {
+		index := h.bucket(t, item)*h.slots + h.victim()
+		h.Table[t][index], item = item, h.Table[t][index]
+
+		// The evicted entry's other bucket is in the other table.
+		t = 1 - t
+		if h.fill(t, item) {
+			return nil
+		}
+	}
+	return item
+}
+
+// fill stores item in a free slot of its bucket in table t.
+func (h *HashTable[K, V]) fill(t int, item *data.Data[K, V]) bool {
+	start := h.bucket(t, item) * h.slots
+	for i := start; i < start+h.slots; i++ {
+		if h.Table[t][i] == nil {
+			h.Table[t][i] = item
+			return true
+		}
+	}
+	return false
+}
+
+// victim picks the slot to evict from a full bucket. Picking at random
+// rather than always the first slot breaks up short eviction cycles.
+func (h *HashTable[K, V]) victim() int32 {
+	if h.slots == 1 {
+		return 0
+	}
+	h.rng ^= h.rng << 13
+	h.rng ^= h.rng >> 17
+	h.rng ^= h.rng << 5
+	return int32(h.rng % uint32(h.slots))
+}
+
+// rehash moves every entry, and pending if it is not nil, into a table with
+// the given number of buckets. If some entry still overflows the stash it
+// retries with twice the buckets, up to MAX_REHASHES times, and reports
+// whether it succeeded. The table is unchanged when it fails.
+func (h *HashTable[K, V]) rehash(buckets int32, pending *data.Data[K, V]) bool {
+	for attempt := 0; attempt < MAX_REHASHES; attempt++ {
+		ht := NewBucketedHashTable[K, V](2*buckets*h.slots, h.slots, h.hasher)
+		ht.building = true
+		if ht.copy(h, pending) == nil {
+			ht.building = false
+			*h = *ht
+			return true
+		}
+		buckets = helpers.NextPrime(2 * buckets)
+	}
+	return false
+}
+
+func (h *HashTable[K, V]) copy(from *HashTable[K, V], pending *data.Data[K, V]) error {
+	items := append([]*data.Data[K, V](nil), from.stash...)
+	if pending != nil {
+		items = append(items, pending)
+	}
+	for _, table := range from.Table {
+		items = append(items, table...)
+	}
+
+	for _, it := range items {
+		if it == nil {
+			continue
+		}
+		if err := h.add(it.GetKey(), it.GetValue()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *HashTable[K, V]) Find(key K) (V, error) {
+	if key == *new(K) {
+		return *new(V), hashtables.ErrInvalidKey
+	}
+	item := h.entry(key)
+	if item == nil {
+		return *new(V), hashtables.NotFound(key)
+	}
+	return item.GetValue(), nil
+}
+
+// Delete also moves stashed entries back into the tables when a slot in one
+// of their buckets frees up.
+func (h *HashTable[K, V]) Delete(key K) error {
+	if key == *new(K) {
+		return hashtables.ErrInvalidKey
+	}
+
+	item := data.NewHashedData(key, *new(V), h.hasher.Hash(key))
+	if !h.remove(item) {
+		return hashtables.NotFound(key)
+	}
+	h.size--
+	h.loadFactor = float32(h.size) / float32(h.GetCapacity())
+
+	stash := h.stash[:0]
+	for _, it := range h.stash {
+		if !h.fill(0, it) && !h.fill(1, it) {
+			stash = append(stash, it)
+		}
+	}
+	for i := len(stash); i < len(h.stash); i++ {
+		h.stash[i] = nil
+	}
+	h.stash = stash
+	return nil
+}
+
+func (h *HashTable[K, V]) remove(item *data.Data[K, V]) bool {
+	for t := 0; t < 2; t++ {
+		start := h.bucket(t, item) * h.slots
+		for i := start; i < start+h.slots; i++ {
+			if v := h.Table[t][i]; v != nil && v.Equal(item) {
+				h.Table[t][i] = nil
+				return true
+			}
+		}
+	}
+	for i, v := range h.stash {
+		if v.Equal(item) {
+			h.stash = append(h.stash[:i], h.stash[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// ProbeLength returns the number of entries inspected to find key.
+func (h *HashTable[K, V]) ProbeLength(key K) (int32, error) {
+	if key == *new(K) {
+		return 0, hashtables.ErrInvalidKey
+	}
+	item, probes := h.lookup(data.NewHashedData(key, *new(V), h.hasher.Hash(key)))
+	if item == nil {
+		return 0, hashtables.NotFound(key)
+	}
+	return probes, nil
+}
+
+// lookup checks both buckets of item and then the stash.
+func (h *HashTable[K, V]) lookup(item *data.Data[K, V]) (*data.Data[K, V], int32) {
+	var probes int32
+	for t := 0; t < 2; t++ {
+		start := h.bucket(t, item) * h.slots
+		for i := start; i < start+h.slots; i++ {
+			if v := h.Table[t][i]; v != nil {
+				probes++
+				if v.Equal(item) {
+					return v, probes
+				}
+			}
+		}
+	}
+	for _, v := range h.stash {
+		probes++
+		if v.Equal(item) {
+			return v, probes
+		}
+	}
+	return nil, probes
+}
+
+// bucket is item's bucket in table t.
+func (h *HashTable[K, V]) bucket(t int, item *data.Data[K, V]) int32 {
+	if t == 0 {
+		return int32(item.GetHash() % uint32(h.buckets))
+	}
+	return int32(h.hasher.Probe(item.GetKey()) % uint32(h.buckets))
+}
+
+// GetCapacity returns the number of slots across both tables, excluding the
+// stash.
+func (h *HashTable[K, V]) GetCapacity() int32 {
+	return 2 * h.buckets * h.slots
+}
+
+func (h *HashTable[K, V]) GetSize() int32 {
+	return h.size
+}
+
+func (h *HashTable[K, V]) GetLoadFactor() float32 {
+	return h.loadFactor
+}
+
+func (h *HashTable[K, V]) GetStashSize() int32 {
+	return int32(len(h.stash))
+}
+
+func (h *HashTable[K, V]) GetSlots() int32 {
+	return h.slots
+}
@@ -0,0 +1,260 @@
+package cuckoo
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Insert(t *testing.T) {
+	is := assert.New(t)
+
+	type args struct {
+		key   string
+		value any
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		setup func(*HashTable[string, any])
+		want  func(*HashTable[string, any], error)
+	}{
+		{
+			name: "insert with invalid key",
+			args: args{
+				key:   "",
+				value: "invalid",
+			},
+			want: func(ht *HashTable[string, any], err error) {
+				is.ErrorIs(err, hashtables.ErrInvalidKey)
+				is.EqualValues(0, ht.GetSize())
+				is.EqualValues(6, ht.GetCapacity())
+			},
+		},
+		{
+			name: "insert a new key-value pair",
+			args: args{
+				key:   "key1",
+				value: "value1",
+			},
+			want: func(ht *HashTable[string, any], err error) {
+				is.Nil(err)
+				is.EqualValues(1, ht.GetSize())
+				value, err := ht.Find("key1")
+				is.Nil(err)
+				is.Equal("value1", value)
+			},
+		},
+		{
+			name: "replace value of previous key",
+			args: args{
+				key:   "key1",
+				value: true,
+			},
+			want: func(ht *HashTable[string, any], err error) {
+				is.Nil(err)
+				is.EqualValues(1, ht.GetSize())
+				value, err := ht.Find("key1")
+				is.Nil(err)
+				is.Equal(true, value)
+			},
+		},
+		{
+			name: "insert to trigger resize",
+			args: args{
+				key:   "resize",
+				value: "value",
+			},
+			setup: func(ht *HashTable[string, any]) {
+				is.Nil(ht.Insert("a", 1))
+			},
+			want: func(ht *HashTable[string, any], err error) {
+				is.Nil(err)
+				for _, key := range []string{"key1", "a", "resize"} {
+					_, err := ht.Find(key)
+					is.Nil(err)
+				}
+				is.EqualValues(14, ht.GetCapacity())
+				is.EqualValues(3, ht.GetSize())
+			},
+		},
+	}
+
+	hashTable := NewHashTable[string, any](0)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(hashTable)
+			}
+			err := hashTable.Insert(tt.args.key, tt.args.value)
+			tt.want(hashTable, err)
+		})
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name  string
+		key   string
+		setup func(*HashTable[string, any])
+		want  func(*HashTable[string, any], error)
+	}{
+		{
+			name: "delete with invalid key",
+			key:  "",
+			want: func(ht *HashTable[string, any], err error) {
+				is.EqualError(err, "invalid key")
+			},
+		},
+		{
+			name: "delete non-existing key",
+			key:  "nonexistent",
+			want: func(ht *HashTable[string, any], err error) {
+				is.EqualError(err, "key nonexistent not found in hashtable")
+			},
+		},
+		{
+			name: "delete one of many keys",
+			key:  "key10",
+			setup: func(ht *HashTable[string, any]) {
+				for i := 0; i < 20; i++ {
+					is.Nil(ht.Insert(fmt.Sprintf("key%d", i), i))
+				}
+			},
+			want: func(ht *HashTable[string, any], err error) {
+				is.Nil(err)
+				is.EqualValues(19, ht.GetSize())
+				_, err = ht.Find("key10")
+				is.EqualError(err, "key key10 not found in hashtable")
+				for i := 0; i < 20; i++ {
+					if i == 10 {
+						continue
+					}
+					value, err := ht.Find(fmt.Sprintf("key%d", i))
+					is.Nil(err)
+					is.Equal(i, value)
+				}
+			},
+		},
+		{
+			name: "delete key twice",
+			key:  "key10",
+			want: func(ht *HashTable[string, any], err error) {
+				is.EqualError(err, "key key10 not found in hashtable")
+				is.EqualValues(19, ht.GetSize())
+			},
+		},
+	}
+
+	hashTable := NewHashTable[string, any](5)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(hashTable)
+			}
+			err := hashTable.Delete(tt.key)
+			tt.want(hashTable, err)
+		})
+	}
+}
+
+func Test_Stash(t *testing.T) {
+	is := assert.New(t)
+
+	// Every key has the same two buckets, so after the first two the rest
+	// can only go to the stash, and no rehash can separate them.
+	ht := NewHashTableWithHash[int, int](0, func(int) uint32 { return 1 })
+	for key := 1; key <= 8; key++ {
+		is.Nil(ht.Insert(key, key))
+	}
+	is.EqualValues(8, ht.GetSize())
+	is.EqualValues(6, ht.GetStashSize())
+	for key := 1; key <= 8; key++ {
+		value, err := ht.Find(key)
+		is.Nil(err)
+		is.Equal(key, value)
+	}
+
+	// Freed slots are taken back by stashed entries.
+	is.Nil(ht.Delete(1))
+	is.Nil(ht.Delete(2))
+	is.EqualValues(6, ht.GetSize())
+	is.EqualValues(4, ht.GetStashSize())
+	for key := 3; key <= 8; key++ {
+		value, err := ht.Find(key)
+		is.Nil(err)
+		is.Equal(key, value)
+	}
+}
+
+func Test_WorstCaseProbes(t *testing.T) {
+	is := assert.New(t)
+
+	for _, slots := range []int32{1, 2, 4, 8} {
+		t.Run(fmt.Sprintf("slots=%d", slots), func(t *testing.T) {
+			ht := NewBucketedHashTable[int, int](0, slots, hashtables.NewXXHasher[int](7))
+			for key := 1; key <= 10000; key++ {
+				is.Nil(ht.Insert(key, key))
+			}
+			is.LessOrEqual(ht.GetStashSize(), int32(STASH_SIZE))
+			is.Less(ht.GetLoadFactor(), ht.maxLoad)
+
+			for key := 1; key <= 10000; key++ {
+				probes, err := ht.ProbeLength(key)
+				is.Nil(err)
+				is.LessOrEqual(probes, 2*slots+STASH_SIZE)
+			}
+		})
+	}
+}
+
+func Test_BucketedLoad(t *testing.T) {
+	is := assert.New(t)
+
+	// A bucketed table fills far past the half that one slot per bucket
+	// allows before it needs to grow.
+	ht := NewBucketedHashTable[int, int](4096, 4, nil)
+	capacity := ht.GetCapacity()
+	n := int(0.9 * float32(capacity))
+	for key := 1; key <= n; key++ {
+		is.Nil(ht.Insert(key, key))
+	}
+	is.Equal(capacity, ht.GetCapacity())
+	is.EqualValues(4, ht.GetSlots())
+}
+
+func Test_RandomOperations(t *testing.T) {
+	is := assert.New(t)
+
+	for _, slots := range []int32{1, 4} {
+		ht := NewBucketedHashTable[int, int](0, slots, nil)
+		want := make(map[int]int)
+		rng := rand.New(rand.NewSource(31))
+
+		for i := 0; i < 20000; i++ {
+			key := rng.Intn(2000) + 1
+			if rng.Intn(3) == 0 {
+				err := ht.Delete(key)
+				_, ok := want[key]
+				is.Equal(ok, err == nil)
+				delete(want, key)
+				continue
+			}
+			is.Nil(ht.Insert(key, i))
+			want[key] = i
+		}
+
+		is.EqualValues(len(want), ht.GetSize())
+		for key, value := range want {
+			got, err := ht.Find(key)
+			is.Nil(err)
+			is.Equal(value, got)
+		}
+	}
+}
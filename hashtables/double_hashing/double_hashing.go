@@ -1,35 +1,82 @@
 package doublehashing
 
 import (
-	"fmt"
-	"hash/fnv"
-
 	"github.com/OladapoAjala/datastructures/hashtables"
 	"github.com/OladapoAjala/datastructures/helpers"
 	"github.com/OladapoAjala/datastructures/sets/data"
-	"golang.org/x/exp/constraints"
 )
 
-type HashTable[K constraints.Ordered] struct {
-	Table      []*data.Data[K, any]
-	capacity   int32
-	size       int32
-	loadFactor float32
+type HashTable[K comparable, V any] struct {
+	Table       []*data.Data[K, V]
+	capacity    int32
+	size        int32
+	loadFactor  float32
+	hasher      hashtables.Hasher[K]
+	version     uint64
+	maxLoad     float32
+	minLoad     float32
+	minCapacity int32
+	incremental bool
+	// old holds the previous table while an incremental resize migrates it.
+	// Slots before rehash have been moved to Table.
+	old    []*data.Data[K, V]
+	rehash int
 }
 
 const (
 	MAX_LOAD_FACTOR  = 0.70
 	DEFAULT_CAPACITY = 7
+	// REHASH_STEP is the number of old slots an incremental resize migrates
+	// on each insert or delete.
+	REHASH_STEP = 4
 )
 
-type HashTabler[K constraints.Ordered] interface {
-	hashtables.HashTabler[K]
+type HashTabler[K comparable, V any] interface {
+	hashtables.HashTabler[K, V]
+	hashtables.Prober[K]
+	hashtables.Ranger[K, V]
+	Iterator() *Iterator[K, V]
+	Stats() hashtables.Stats
 	GetLoadFactor() float32
+	IsRehashing() bool
+}
+
+var _ HashTabler[string, any] = new(HashTable[string, any])
+
+func NewHashTable[K comparable, V any](capacity int32) *HashTable[K, V] {
+	return NewHashTableWithHasher[K, V](capacity, hashtables.FNVHasher[K]{})
 }
 
-var _ HashTabler[string] = new(HashTable[string])
+// NewHashTableWithHash places keys with hash and derives the probe step from
+// it with hashtables.Mix.
+func NewHashTableWithHash[K comparable, V any](capacity int32, hash hashtables.HashFunc[K]) *HashTable[K, V] {
+	return NewHashTableWithHasher[K, V](capacity, hash)
+}
+
+// NewHashTableWithHasher places keys with hasher.Hash and takes the probe
+// step from hasher.Probe.
+func NewHashTableWithHasher[K comparable, V any](capacity int32, hasher hashtables.Hasher[K]) *HashTable[K, V] {
+	ht, _ := NewHashTableWithConfig[K, V](capacity, hashtables.Config[K]{Hasher: hasher})
+	return ht
+}
+
+// NewHashTableWithConfig returns hashtables.ErrInvalidConfig unless
+// MaxLoadFactor is below 1 and MinLoadFactor below half of it. Tables grow
+// to the first prime past twice their capacity, which for incremental tables
+// leaves room for a migration to finish before the next resize is due. The
+// table never shrinks below its initial capacity.
+func NewHashTableWithConfig[K comparable, V any](capacity int32, config hashtables.Config[K]) (*HashTable[K, V], error) {
+	if config.Hasher == nil {
+		config.Hasher = hashtables.FNVHasher[K]{}
+	}
+	if config.MaxLoadFactor == 0 {
+		config.MaxLoadFactor = MAX_LOAD_FACTOR
+	}
+	if config.MaxLoadFactor < 0 || config.MaxLoadFactor >= 1 ||
+		config.MinLoadFactor < 0 || config.MinLoadFactor >= config.MaxLoadFactor/2 {
+		return nil, hashtables.ErrInvalidConfig
+	}
 
-func NewHashTable[K constraints.Ordered](capacity int32) *HashTable[K] {
 	var cap int32
 	if capacity == 0 {
 		cap = DEFAULT_CAPACITY
@@ -38,142 +85,224 @@ func NewHashTable[K constraints.Ordered](capacity int32) *HashTable[K] {
 	} else {
 		cap = helpers.NextPrime(capacity)
 	}
-	table := make([]*data.Data[K, any], cap)
+	table := make([]*data.Data[K, V], cap)
 
-	return &HashTable[K]{
-		capacity:   cap,
-		size:       0,
-		loadFactor: 0,
-		Table:      table,
-	}
+	return &HashTable[K, V]{
+		capacity:    cap,
+		size:        0,
+		loadFactor:  0,
+		Table:       table,
+		hasher:      config.Hasher,
+		maxLoad:     config.MaxLoadFactor,
+		minLoad:     config.MinLoadFactor,
+		minCapacity: cap,
+		incremental: config.Incremental,
+	}, nil
 }
 
-func (h *HashTable[K]) Insert(key K, value any) error {
+func (h *HashTable[K, V]) Insert(key K, value V) error {
 	if key == *new(K) {
-		return fmt.Errorf("invalid key")
+		return hashtables.ErrInvalidKey
+	}
+	if item := h.entry(key); item != nil {
+		item.Value = value
+		return nil
 	}
-	item := data.NewDataWithHash(key, value)
-	index := item.GetHash() % uint32(h.capacity)
+	return h.add(key, value)
+}
 
-	switch v := h.Table[index]; {
-	case v == nil || v.IsTombStone():
-		h.Table[index] = item
-	case v.Key == key:
-		v.Value = value
+// entry returns the stored item for key, or nil if key is absent.
+func (h *HashTable[K, V]) entry(key K) *data.Data[K, V] {
+	table, index, _, ok := h.locate(key)
+	if !ok {
 		return nil
-	default:
-		var x uint32 = 1
-		h1 := index
-		for v != nil {
-			index = h.getNextIndex(v, h1, x)
-			v = h.Table[index]
-			x++
-		}
-		h.Table[index] = item
 	}
+	return table[index]
+}
 
+// add stores a key that is known to be absent.
+func (h *HashTable[K, V]) add(key K, value V) error {
+	h.migrate(REHASH_STEP)
+	h.place(h.Table, data.NewHashedData(key, value, h.hasher.Hash(key)))
+
+	h.version++
 	h.size++
 	h.loadFactor = float32(h.size) / float32(h.capacity)
-	if h.loadFactor >= MAX_LOAD_FACTOR {
-		return h.resize()
+	if h.loadFactor >= h.maxLoad {
+		h.resize(helpers.NextPrime(2 * h.capacity))
 	}
 	return nil
 }
 
-func (h *HashTable[K]) resize() error {
-	cap := helpers.NextPrime(h.GetCapacity())
-	ht := NewHashTable[K](cap)
+// place puts item, whose key is absent, into the first free or tombstoned
+// slot of its probe sequence.
+func (h *HashTable[K, V]) place(table []*data.Data[K, V], item *data.Data[K, V]) {
+	cap := uint32(len(table))
+	home := item.GetHash() % cap
+	delta := h.step(item.GetKey(), cap)
 
-	for _, it := range h.Table {
-		if it == nil || it.IsTombStone() {
-			continue
+	index := home
+	for x := uint32(1); table[index] != nil && !table[index].IsTombStone(); x++ {
+		index = (home + x*delta) % cap
+	}
+	table[index] = item
+}
+
+// resize moves the entries into a table of the given capacity, either at
+// once or, for incremental tables, over the following operations.
+func (h *HashTable[K, V]) resize(capacity int32) {
+	h.migrate(len(h.old))
+
+	old := h.Table
+	h.Table = make([]*data.Data[K, V], capacity)
+	h.capacity = capacity
+	h.loadFactor = float32(h.size) / float32(h.capacity)
+
+	if h.incremental {
+		h.old = old
+		h.rehash = 0
+		return
+	}
+	for _, it := range old {
+		if it != nil && !it.IsTombStone() {
+			h.place(h.Table, it)
 		}
-		err := ht.Insert(it.GetKey(), it.GetValue())
-		if err != nil {
-			return err
+	}
+}
+
+// migrate moves up to n slots of an in-progress incremental resize into
+// Table. Migrated slots keep their items so that probe sequences through
+// them stay intact; locate ignores matches before the rehash cursor.
+func (h *HashTable[K, V]) migrate(n int) {
+	for ; n > 0 && h.rehash < len(h.old); n-- {
+		if it := h.old[h.rehash]; it != nil && !it.IsTombStone() {
+			h.place(h.Table, it)
 		}
+		h.rehash++
 	}
+	if h.old != nil && h.rehash == len(h.old) {
+		h.old = nil
+		h.rehash = 0
+	}
+}
 
-	h.Table = ht.Table
-	h.capacity = ht.capacity
-	h.size = ht.size
-	h.loadFactor = ht.loadFactor
-	return nil
+// IsRehashing reports whether an incremental resize is in progress.
+func (h *HashTable[K, V]) IsRehashing() bool {
+	return h.old != nil
 }
 
-func (h *HashTable[K]) Find(key K) (any, error) {
-	index, err := h.getIndex(key)
-	if err != nil {
-		return nil, err
+func (h *HashTable[K, V]) Find(key K) (V, error) {
+	if key == *new(K) {
+		return *new(V), hashtables.ErrInvalidKey
+	}
+	table, index, _, ok := h.locate(key)
+	if !ok {
+		return *new(V), hashtables.NotFound(key)
 	}
-	item := h.Table[index]
-	return item.GetValue(), nil
+	return table[index].GetValue(), nil
 }
 
-func (h *HashTable[K]) getIndex(key K) (int32, error) {
+// ProbeLength returns the number of slots inspected to find key.
+func (h *HashTable[K, V]) ProbeLength(key K) (int32, error) {
 	if key == *new(K) {
-		return -1, fmt.Errorf("invalid key")
+		return 0, hashtables.ErrInvalidKey
 	}
+	_, _, probes, ok := h.locate(key)
+	if !ok {
+		return 0, hashtables.NotFound(key)
+	}
+	return probes, nil
+}
 
-	data, index := h.GetData(key)
-	if data == nil {
-		return -1, fmt.Errorf("key %v not found in hashtable", key)
-	} else if data.Key == key {
-		return int32(index), nil
-	} else {
-		var x uint32 = 1
-		h1 := index
-
-		for !data.IsTombStone() {
-			index = h.getNextIndex(data, h1, x)
-			data = h.Table[index]
-			if data == nil {
-				return -1, fmt.Errorf("key %v not found in hashtable", key)
-			}
-			if data.Key == key {
-				return int32(index), nil
-			}
-			x++
-		}
+// locate finds key in Table or, during an incremental resize, in the old
+// slots that have not been migrated yet.
+func (h *HashTable[K, V]) locate(key K) ([]*data.Data[K, V], uint32, int32, bool) {
+	index, probes, ok := h.search(h.Table, key)
+	if ok || h.old == nil {
+		return h.Table, index, probes, ok
+	}
+	oldIndex, oldProbes, ok := h.search(h.old, key)
+	if !ok || int(oldIndex) < h.rehash {
+		return nil, 0, 0, false
 	}
+	return h.old, oldIndex, probes + oldProbes, true
+}
 
-	return -1, fmt.Errorf("key %v not found in hashtable", key)
+// search reports the slot of key in table and the number of slots
+// inspected. It walks the key's probe sequence past tombstones until it
+// reaches an empty slot.
+func (h *HashTable[K, V]) search(table []*data.Data[K, V], key K) (uint32, int32, bool) {
+	cap := uint32(len(table))
+	home := h.hasher.Hash(key) % cap
+	delta := h.step(key, cap)
+
+	index := home
+	for probes := int32(1); probes <= int32(cap); probes++ {
+		item := table[index]
+		if item == nil {
+			return 0, probes, false
+		}
+		if !item.IsTombStone() && item.Key == key {
+			return index, probes, true
+		}
+		index = (home + uint32(probes)*delta) % cap
+	}
+	return 0, int32(cap), false
 }
 
-func (h *HashTable[K]) Delete(key K) error {
-	index, err := h.getIndex(key)
-	if err != nil {
-		return err
+func (h *HashTable[K, V]) Delete(key K) error {
+	if key == *new(K) {
+		return hashtables.ErrInvalidKey
+	}
+	table, index, _, ok := h.locate(key)
+	if !ok {
+		return hashtables.NotFound(key)
 	}
-	h.Table[index] = data.NewTombStone[K, any]()
+	table[index] = data.NewTombStone[K, V]()
+	h.version++
 	h.size--
 	h.loadFactor = float32(h.size) / float32(h.capacity)
+
+	h.migrate(REHASH_STEP)
+	if h.old == nil && h.loadFactor < h.minLoad {
+		h.shrink()
+	}
 	return nil
 }
 
-func (h *HashTable[K]) GetData(key K) (*data.Data[K, any], uint32) {
-	hasher := fnv.New32()
-	hasher.Write([]byte(data.ToString(key)))
-	index := hasher.Sum32() % uint32(h.capacity)
+func (h *HashTable[K, V]) shrink() {
+	cap := helpers.NextPrime(h.capacity / 2)
+	if cap < h.minCapacity {
+		cap = h.minCapacity
+	}
+	if cap < h.capacity && float32(h.size)/float32(cap) < h.maxLoad {
+		h.resize(cap)
+	}
+}
+
+func (h *HashTable[K, V]) GetData(key K) (*data.Data[K, V], uint32) {
+	index := h.hasher.Hash(key) % uint32(h.capacity)
 	return h.Table[index], index
 }
 
-func (h *HashTable[K]) GetCapacity() int32 {
+func (h *HashTable[K, V]) GetCapacity() int32 {
 	return h.capacity
 }
 
-func (h *HashTable[K]) GetSize() int32 {
+func (h *HashTable[K, V]) GetSize() int32 {
 	return h.size
 }
 
-func (h *HashTable[K]) GetLoadFactor() float32 {
+func (h *HashTable[K, V]) GetLoadFactor() float32 {
 	return h.loadFactor
 }
 
-func (h *HashTable[K]) getNextIndex(item *data.Data[K, any], offset, x uint32) uint32 {
-	delta := item.Probe() % uint32(h.capacity)
+// step is the probe stride for key. Capacities are prime, so any non-zero
+// stride visits every slot.
+func (h *HashTable[K, V]) step(key K, cap uint32) uint32 {
+	delta := h.hasher.Probe(key) % cap
 	if delta == 0 {
 		delta = 1
 	}
-	return (offset + (x * delta)) % uint32(h.capacity)
+	return delta
 }
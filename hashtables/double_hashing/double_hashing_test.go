@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/OladapoAjala/datastructures/hashtables"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -18,8 +19,8 @@ func Test_Insert(t *testing.T) {
 	tests := []struct {
 		name  string
 		args  args
-		setup func(*HashTable[string], string)
-		want  func(*HashTable[string], error)
+		setup func(*HashTable[string, any], string)
+		want  func(*HashTable[string, any], error)
 	}{
 		{
 			name: "insert with invalid key",
@@ -27,7 +28,7 @@ func Test_Insert(t *testing.T) {
 				key:   "",
 				value: "invalid",
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Error(fmt.Errorf("invalid key"))
 				is.EqualValues(ht.GetSize(), 0)
 				is.EqualValues(ht.GetCapacity(), 5)
@@ -41,7 +42,7 @@ func Test_Insert(t *testing.T) {
 				key:   "key1",
 				value: "value1",
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
 				is.Equal(ht.Table[3].GetKey(), "key1")
 				is.Equal(ht.Table[3].GetValue(), "value1")
@@ -56,7 +57,7 @@ func Test_Insert(t *testing.T) {
 				key:   "key1",
 				value: true,
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
 				is.Equal(ht.Table[3].GetKey(), "key1")
 				is.Equal(ht.Table[3].GetValue(), true)
@@ -71,7 +72,7 @@ func Test_Insert(t *testing.T) {
 				key:   "key0",
 				value: "value2",
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
 				is.Equal(ht.Table[2].GetKey(), "key0")
 				is.Equal(ht.Table[2].GetValue(), "value2")
@@ -86,7 +87,7 @@ func Test_Insert(t *testing.T) {
 				key:   "key4",
 				value: []int{1, 9, 9, 9},
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
 				is.Equal(ht.Table[1].GetKey(), "key4")
 				is.Equal(ht.Table[1].GetValue(), []int{1, 9, 9, 9})
@@ -101,21 +102,21 @@ func Test_Insert(t *testing.T) {
 				key:   "resize1",
 				value: "value1",
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
 
-				is.Equal(ht.Table[1].GetKey(), "key0")
-				is.Equal(ht.Table[1].GetValue(), "value2")
-				is.Equal(ht.Table[2].GetKey(), "key1")
-				is.Equal(ht.Table[2].GetValue(), true)
-				is.Equal(ht.Table[4].GetKey(), "key4")
-				is.Equal(ht.Table[4].GetValue(), []int{1, 9, 9, 9})
-				is.Equal(ht.Table[5].GetKey(), "resize1")
-				is.Equal(ht.Table[5].GetValue(), "value1")
+				is.Equal(ht.Table[6].GetKey(), "key0")
+				is.Equal(ht.Table[6].GetValue(), "value2")
+				is.Equal(ht.Table[7].GetKey(), "key1")
+				is.Equal(ht.Table[7].GetValue(), true)
+				is.Equal(ht.Table[2].GetKey(), "key4")
+				is.Equal(ht.Table[2].GetValue(), []int{1, 9, 9, 9})
+				is.Equal(ht.Table[0].GetKey(), "resize1")
+				is.Equal(ht.Table[0].GetValue(), "value1")
 
-				is.EqualValues(ht.GetCapacity(), 7)
+				is.EqualValues(ht.GetCapacity(), 11)
 				is.EqualValues(ht.GetSize(), 4)
-				is.EqualValues(ht.GetLoadFactor(), float32(0.5714286))
+				is.EqualValues(ht.GetLoadFactor(), float32(0.36363637))
 			},
 		},
 		{
@@ -124,24 +125,24 @@ func Test_Insert(t *testing.T) {
 				key:   "key4",
 				value: "rebirth",
 			},
-			setup: func(ht *HashTable[string], key string) {
+			setup: func(ht *HashTable[string, any], key string) {
 				err := ht.Delete(key)
 				is.Nil(err)
-				is.EqualValues(ht.GetCapacity(), 7)
+				is.EqualValues(ht.GetCapacity(), 11)
 				is.EqualValues(ht.GetSize(), 3)
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
-				is.Equal(ht.Table[4].GetKey(), "key4")
-				is.Equal(ht.Table[4].GetValue(), "rebirth")
-				is.EqualValues(ht.GetCapacity(), 7)
+				is.Equal(ht.Table[2].GetKey(), "key4")
+				is.Equal(ht.Table[2].GetValue(), "rebirth")
+				is.EqualValues(ht.GetCapacity(), 11)
 				is.EqualValues(ht.GetSize(), 4)
-				is.EqualValues(ht.GetLoadFactor(), float32(0.5714286))
+				is.EqualValues(ht.GetLoadFactor(), float32(0.36363637))
 			},
 		},
 	}
 
-	hashTable := NewHashTable[string](5)
+	hashTable := NewHashTable[string, any](5)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.setup != nil {
@@ -163,7 +164,7 @@ func Test_Find(t *testing.T) {
 	tests := []struct {
 		name  string
 		args  args
-		setup func(*HashTable[string], string)
+		setup func(*HashTable[string, any], string)
 		want  func(any, error)
 	}{
 		{
@@ -191,7 +192,7 @@ func Test_Find(t *testing.T) {
 			args: args{
 				key: "key1",
 			},
-			setup: func(ht *HashTable[string], key string) {
+			setup: func(ht *HashTable[string, any], key string) {
 				err := ht.Insert(key, "value1")
 				is.Nil(err)
 			},
@@ -205,7 +206,7 @@ func Test_Find(t *testing.T) {
 			args: args{
 				key: "key0",
 			},
-			setup: func(ht *HashTable[string], key string) {
+			setup: func(ht *HashTable[string, any], key string) {
 				err := ht.Insert(key, "value2")
 				is.Nil(err)
 			},
@@ -219,7 +220,7 @@ func Test_Find(t *testing.T) {
 			args: args{
 				key: "key4",
 			},
-			setup: func(ht *HashTable[string], key string) {
+			setup: func(ht *HashTable[string, any], key string) {
 				err := ht.Insert(key, []int{1, 9, 9, 9})
 				is.Nil(err)
 			},
@@ -233,7 +234,7 @@ func Test_Find(t *testing.T) {
 			args: args{
 				key: "key5", // I need something that clashes with key4 on resize.
 			},
-			setup: func(ht *HashTable[string], key string) {
+			setup: func(ht *HashTable[string, any], key string) {
 				err := ht.Insert(key, "resizeValue")
 				is.Nil(err)
 			},
@@ -247,7 +248,7 @@ func Test_Find(t *testing.T) {
 			args: args{
 				key: "key5",
 			},
-			setup: func(ht *HashTable[string], key string) {
+			setup: func(ht *HashTable[string, any], key string) {
 				err := ht.Delete("key4")
 				is.Nil(err)
 			},
@@ -261,7 +262,7 @@ func Test_Find(t *testing.T) {
 			args: args{
 				key: "key5",
 			},
-			setup: func(ht *HashTable[string], key string) {
+			setup: func(ht *HashTable[string, any], key string) {
 				err := ht.Delete(key)
 				is.Nil(err)
 			},
@@ -272,7 +273,7 @@ func Test_Find(t *testing.T) {
 		},
 	}
 
-	hashTable := NewHashTable[string](5)
+	hashTable := NewHashTable[string, any](5)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.setup != nil {
@@ -294,15 +295,15 @@ func Test_Delete(t *testing.T) {
 	tests := []struct {
 		name  string
 		args  args
-		setup func(*HashTable[string], string)
-		want  func(*HashTable[string], error)
+		setup func(*HashTable[string, any], string)
+		want  func(*HashTable[string, any], error)
 	}{
 		{
 			name: "delete with invalid key",
 			args: args{
 				key: "",
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Error(fmt.Errorf("invalid key"), err)
 				is.EqualValues(ht.GetCapacity(), 5)
 				is.EqualValues(ht.GetSize(), 0)
@@ -313,7 +314,7 @@ func Test_Delete(t *testing.T) {
 			args: args{
 				key: "nonexistent",
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Error(fmt.Errorf("key nonexistent not found in hashtable"), err)
 				is.EqualValues(ht.GetCapacity(), 5)
 				is.EqualValues(ht.GetSize(), 0)
@@ -324,11 +325,11 @@ func Test_Delete(t *testing.T) {
 			args: args{
 				key: "key1",
 			},
-			setup: func(ht *HashTable[string], key string) {
+			setup: func(ht *HashTable[string, any], key string) {
 				err := ht.Insert(key, "value1")
 				is.Nil(err)
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
 				is.EqualValues(ht.GetCapacity(), 5)
 				is.EqualValues(ht.GetSize(), 0)
@@ -339,7 +340,7 @@ func Test_Delete(t *testing.T) {
 			args: args{
 				key: "key0",
 			},
-			setup: func(ht *HashTable[string], key string) {
+			setup: func(ht *HashTable[string, any], key string) {
 				err := ht.Insert(key, "value2")
 				is.Nil(err)
 				err = ht.Insert("key1", "value1")
@@ -353,7 +354,7 @@ func Test_Delete(t *testing.T) {
 				is.Nil(err)
 				is.Equal(val, "value1")
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
 				is.EqualValues(ht.GetCapacity(), 5)
 				is.EqualValues(ht.GetSize(), 1)
@@ -364,11 +365,11 @@ func Test_Delete(t *testing.T) {
 			args: args{
 				key: "key4",
 			},
-			setup: func(ht *HashTable[string], key string) {
+			setup: func(ht *HashTable[string, any], key string) {
 				err := ht.Insert(key, []int{1, 9, 9, 9})
 				is.Nil(err)
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
 				is.EqualValues(ht.GetCapacity(), 5)
 				is.EqualValues(ht.GetSize(), 1)
@@ -379,7 +380,7 @@ func Test_Delete(t *testing.T) {
 			args: args{
 				key: "key5",
 			},
-			setup: func(ht *HashTable[string], key string) {
+			setup: func(ht *HashTable[string, any], key string) {
 				for i := 0; i < 2; i++ {
 					err := ht.Insert(fmt.Sprintf("key_%d", i), fmt.Sprintf("value%d", i))
 					is.Nil(err)
@@ -387,9 +388,9 @@ func Test_Delete(t *testing.T) {
 				err := ht.Insert(key, "resizeValue")
 				is.Nil(err)
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
-				is.EqualValues(ht.GetCapacity(), 7)
+				is.EqualValues(ht.GetCapacity(), 11)
 				is.EqualValues(ht.GetSize(), 3)
 			},
 		},
@@ -398,15 +399,15 @@ func Test_Delete(t *testing.T) {
 			args: args{
 				key: "key5",
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Error(fmt.Errorf("key key5 not found in hashtable"), err)
-				is.EqualValues(ht.GetCapacity(), 7)
+				is.EqualValues(ht.GetCapacity(), 11)
 				is.EqualValues(ht.GetSize(), 3)
 			},
 		},
 	}
 
-	hashTable := NewHashTable[string](5)
+	hashTable := NewHashTable[string, any](5)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.setup != nil {
@@ -417,3 +418,61 @@ func Test_Delete(t *testing.T) {
 		})
 	}
 }
+
+func Test_ComparableKeys(t *testing.T) {
+	is := assert.New(t)
+
+	type point struct {
+		x, y int
+	}
+
+	tests := []struct {
+		name string
+		ht   *HashTable[point, string]
+	}{
+		{
+			name: "struct keys with the default hash",
+			ht:   NewHashTable[point, string](5),
+		},
+		{
+			name: "struct keys with a custom hash",
+			ht: NewHashTableWithHash[point, string](5, func(p point) uint32 {
+				return uint32(p.x*31 + p.y)
+			}),
+		},
+		{
+			name: "struct keys with a colliding hash",
+			ht: NewHashTableWithHash[point, string](5, func(p point) uint32 {
+				return 7
+			}),
+		},
+		{
+			name: "struct keys with a seeded hasher",
+			ht:   NewHashTableWithHasher[point, string](5, hashtables.NewSipHasher[point](1, 2)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 1; i <= 20; i++ {
+				is.Nil(tt.ht.Insert(point{i, -i}, fmt.Sprintf("p%d", i)))
+			}
+			is.EqualValues(20, tt.ht.GetSize())
+
+			value, err := tt.ht.Find(point{7, -7})
+			is.Nil(err)
+			is.Equal("p7", value)
+
+			is.Nil(tt.ht.Delete(point{7, -7}))
+			value, err = tt.ht.Find(point{7, -7})
+			is.Error(err)
+			is.Empty(value)
+
+			_, err = tt.ht.Find(point{7, 7})
+			is.Error(err)
+
+			err = tt.ht.Insert(point{}, "zero")
+			is.EqualError(err, "invalid key")
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package doublehashing
+
+import (
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/OladapoAjala/datastructures/sets/data"
+)
+
+// Iterator visits the table's entries in slot order. It is fail-fast: once
+// the table gains or loses a key, Next returns false and Err reports
+// hashtables.ErrConcurrentModification. Overwriting the value of an existing
+// key is not a structural change and is seen by the iterator.
+type Iterator[K comparable, V any] struct {
+	table   *HashTable[K, V]
+	version uint64
+	index   int
+	current *data.Data[K, V]
+	err     error
+}
+
+var _ hashtables.Iterator[string, any] = new(Iterator[string, any])
+
+func (h *HashTable[K, V]) Iterator() *Iterator[K, V] {
+	return &Iterator[K, V]{
+		table:   h,
+		version: h.version,
+		index:   -1,
+	}
+}
+
+func (it *Iterator[K, V]) Next() bool {
+	it.current = nil
+	if it.err != nil {
+		return false
+	}
+	if it.version != it.table.version {
+		it.err = hashtables.ErrConcurrentModification
+		return false
+	}
+
+	// During an incremental resize the unmigrated old slots follow Table.
+	h := it.table
+	for it.index++; it.index < len(h.Table)+len(h.old); it.index++ {
+		var v *data.Data[K, V]
+		if it.index < len(h.Table) {
+			v = h.Table[it.index]
+		} else if i := it.index - len(h.Table); i >= h.rehash {
+			v = h.old[i]
+		}
+		if v != nil && !v.IsTombStone() {
+			it.current = v
+			return true
+		}
+	}
+	return false
+}
+
+func (it *Iterator[K, V]) Key() K {
+	if it.current == nil {
+		return *new(K)
+	}
+	return it.current.GetKey()
+}
+
+func (it *Iterator[K, V]) Value() V {
+	if it.current == nil {
+		return *new(V)
+	}
+	return it.current.GetValue()
+}
+
+func (it *Iterator[K, V]) Err() error {
+	return it.err
+}
+
+// Range calls f for each entry until f returns false. f may overwrite
+// values, but Range panics with hashtables.ErrConcurrentModification if f
+// inserts a new key or deletes one.
+func (h *HashTable[K, V]) Range(f func(K, V) bool) {
+	it := h.Iterator()
+	for it.Next() {
+		if !f(it.Key(), it.Value()) {
+			return
+		}
+	}
+	if err := it.Err(); err != nil {
+		panic(err)
+	}
+}
+
+func (h *HashTable[K, V]) Keys() []K {
+	keys := make([]K, 0, h.size)
+	h.Range(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+func (h *HashTable[K, V]) Values() []V {
+	values := make([]V, 0, h.size)
+	h.Range(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
@@ -0,0 +1,213 @@
+package doublehashing
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewHashTableWithConfig(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name    string
+		config  hashtables.Config[int]
+		wantErr bool
+	}{
+		{
+			name:   "zero config takes the defaults",
+			config: hashtables.Config[int]{},
+		},
+		{
+			name:   "custom load factors",
+			config: hashtables.Config[int]{MaxLoadFactor: 0.5, MinLoadFactor: 0.1},
+		},
+		{
+			name:    "max load factor of one",
+			config:  hashtables.Config[int]{MaxLoadFactor: 1},
+			wantErr: true,
+		},
+		{
+			name:    "negative max load factor",
+			config:  hashtables.Config[int]{MaxLoadFactor: -0.5},
+			wantErr: true,
+		},
+		{
+			name:    "min load factor too close to max",
+			config:  hashtables.Config[int]{MaxLoadFactor: 0.6, MinLoadFactor: 0.3},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ht, err := NewHashTableWithConfig[int, int](7, tt.config)
+			if tt.wantErr {
+				is.ErrorIs(err, hashtables.ErrInvalidConfig)
+				is.Nil(ht)
+				return
+			}
+			is.Nil(err)
+			is.EqualValues(7, ht.GetCapacity())
+		})
+	}
+}
+
+func Test_MaxLoadFactor(t *testing.T) {
+	is := assert.New(t)
+
+	ht, err := NewHashTableWithConfig[int, int](11, hashtables.Config[int]{MaxLoadFactor: 0.5})
+	is.Nil(err)
+	for key := 1; key <= 5; key++ {
+		is.Nil(ht.Insert(key, key))
+	}
+	is.EqualValues(11, ht.GetCapacity())
+	is.Nil(ht.Insert(6, 6))
+	is.EqualValues(23, ht.GetCapacity())
+
+	for key := 7; key <= 200; key++ {
+		is.Nil(ht.Insert(key, key))
+		is.Less(ht.GetLoadFactor(), float32(0.5))
+	}
+}
+
+func Test_Shrink(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name        string
+		incremental bool
+		want        func(ht *HashTable[int, int], grown int32)
+	}{
+		{
+			name: "shrinks until the load is back above the minimum",
+			want: func(ht *HashTable[int, int], grown int32) {
+				is.GreaterOrEqual(ht.GetLoadFactor(), float32(0.2))
+				is.False(ht.IsRehashing())
+			},
+		},
+		{
+			name:        "incremental shrink migrates as keys are deleted",
+			incremental: true,
+			want: func(ht *HashTable[int, int], grown int32) {
+				is.Less(ht.GetCapacity(), grown)
+				is.GreaterOrEqual(ht.GetCapacity(), int32(7))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ht, err := NewHashTableWithConfig[int, int](7, hashtables.Config[int]{
+				MinLoadFactor: 0.2,
+				Incremental:   tt.incremental,
+			})
+			is.Nil(err)
+
+			for key := 1; key <= 1000; key++ {
+				is.Nil(ht.Insert(key, key))
+			}
+			grown := ht.GetCapacity()
+
+			for key := 1; key <= 995; key++ {
+				is.Nil(ht.Delete(key))
+			}
+			for key := 996; key <= 1000; key++ {
+				value, err := ht.Find(key)
+				is.Nil(err)
+				is.Equal(key, value)
+			}
+			is.EqualValues(5, ht.GetSize())
+			tt.want(ht, grown)
+		})
+	}
+
+	ht := NewHashTable[int, int](7)
+	for key := 1; key <= 100; key++ {
+		is.Nil(ht.Insert(key, key))
+	}
+	grown := ht.GetCapacity()
+	for key := 1; key <= 100; key++ {
+		is.Nil(ht.Delete(key))
+	}
+	is.Equal(grown, ht.GetCapacity())
+}
+
+func Test_IncrementalResize(t *testing.T) {
+	is := assert.New(t)
+
+	ht, err := NewHashTableWithConfig[int, int](7, hashtables.Config[int]{
+		MinLoadFactor: 0.1,
+		Incremental:   true,
+	})
+	is.Nil(err)
+
+	r := rand.New(rand.NewSource(35))
+	want := map[int]int{}
+	rehashed := 0
+
+	for i := 0; i < 20000; i++ {
+		key := r.Intn(3000) + 1
+		switch op := r.Intn(10); {
+		case op < 6 || i < 5000:
+			is.Nil(ht.Insert(key, i))
+			want[key] = i
+		case op < 9 || i > 15000:
+			err := ht.Delete(key)
+			if _, ok := want[key]; ok {
+				is.Nil(err)
+				delete(want, key)
+			} else {
+				is.ErrorIs(err, hashtables.ErrNotFound)
+			}
+		default:
+			value, err := ht.Find(key)
+			if v, ok := want[key]; ok {
+				is.Nil(err)
+				is.Equal(v, value)
+			} else {
+				is.ErrorIs(err, hashtables.ErrNotFound)
+			}
+		}
+
+		if ht.IsRehashing() {
+			rehashed++
+			is.LessOrEqual(ht.rehash, len(ht.old))
+		}
+		is.EqualValues(len(want), ht.GetSize())
+	}
+	is.Greater(rehashed, 0)
+
+	keys := ht.Keys()
+	is.Len(keys, len(want))
+	for _, key := range keys {
+		value, err := ht.Find(key)
+		is.Nil(err)
+		is.Equal(want[key], value)
+	}
+}
+
+func Test_IncrementalIteration(t *testing.T) {
+	is := assert.New(t)
+
+	ht, err := NewHashTableWithConfig[int, int](7, hashtables.Config[int]{Incremental: true})
+	is.Nil(err)
+
+	want := []int{}
+	for key := 1; !ht.IsRehashing() || len(want) < 10; key++ {
+		is.Nil(ht.Insert(key, key))
+		want = append(want, key)
+	}
+	is.True(ht.IsRehashing())
+
+	keys := ht.Keys()
+	sort.Ints(keys)
+	is.Equal(want, keys)
+
+	probes, err := ht.ProbeLength(1)
+	is.Nil(err)
+	is.Greater(probes, int32(0))
+}
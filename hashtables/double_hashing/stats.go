@@ -0,0 +1,55 @@
+package doublehashing
+
+import "github.com/OladapoAjala/datastructures/hashtables"
+
+// Stats reports how the table's slots are used. A chain is a run of
+// consecutive occupied or tombstoned slots, the clusters that probe
+// sequences cross. EffectiveLoadFactor counts tombstones as occupied, since
+// lookups probe past them. During an incremental resize the slot counts
+// describe Table only, while the probe lengths cover every key.
+func (h *HashTable[K, V]) Stats() hashtables.Stats {
+	stats := hashtables.Stats{
+		Size:       h.size,
+		Capacity:   h.capacity,
+		LoadFactor: h.loadFactor,
+	}
+
+	var used int32
+	start := -1
+	for i, it := range h.Table {
+		if it == nil {
+			start = i
+			continue
+		}
+		used++
+		if it.IsTombStone() {
+			stats.Tombstones++
+		}
+	}
+	stats.EffectiveLoadFactor = float32(used) / float32(h.capacity)
+
+	if start < 0 {
+		stats.AddChain(h.capacity)
+	} else {
+		// Start after an empty slot so that a run wrapping past the end is
+		// counted once.
+		var run int32
+		for i := 1; i <= len(h.Table); i++ {
+			if h.Table[(start+i)%len(h.Table)] != nil {
+				run++
+				continue
+			}
+			if run > 0 {
+				stats.AddChain(run)
+			}
+			run = 0
+		}
+	}
+
+	h.Range(func(key K, _ V) bool {
+		probes, _ := h.ProbeLength(key)
+		stats.AddProbe(probes)
+		return true
+	})
+	return stats
+}
@@ -0,0 +1,111 @@
+package doublehashing
+
+import (
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Stats(t *testing.T) {
+	is := assert.New(t)
+
+	// Every key shares a home slot and stride, so each one probes one slot
+	// further than the last.
+	ht := NewHashTableWithHash[int, int](11, func(int) uint32 { return 1 })
+
+	tests := []struct {
+		name   string
+		action func()
+		want   func(hashtables.Stats)
+	}{
+		{
+			name: "empty table",
+			want: func(s hashtables.Stats) {
+				is.EqualValues(11, s.Capacity)
+				is.Zero(s.Size)
+				is.Empty(s.ProbeLengths)
+				is.Empty(s.ChainLengths)
+				is.Zero(s.LongestChain)
+				is.Zero(s.MeanProbeLength())
+			},
+		},
+		{
+			name: "colliding keys",
+			action: func() {
+				for key := 1; key <= 4; key++ {
+					is.Nil(ht.Insert(key, key))
+				}
+			},
+			want: func(s hashtables.Stats) {
+				is.EqualValues(4, s.Size)
+				is.Zero(s.Tombstones)
+				is.Equal([]int32{0, 1, 1, 1, 1}, s.ProbeLengths)
+				is.EqualValues(4, s.MaxProbeLength())
+				is.Equal(2.5, s.MeanProbeLength())
+				is.InDelta(4.0/11, s.LoadFactor, 1e-6)
+				is.InDelta(4.0/11, s.EffectiveLoadFactor, 1e-6)
+			},
+		},
+		{
+			name: "deletes leave tombstones",
+			action: func() {
+				is.Nil(ht.Delete(1))
+				is.Nil(ht.Delete(3))
+			},
+			want: func(s hashtables.Stats) {
+				is.EqualValues(2, s.Size)
+				is.EqualValues(2, s.Tombstones)
+				is.Equal([]int32{0, 0, 1, 0, 1}, s.ProbeLengths)
+				is.InDelta(2.0/11, s.LoadFactor, 1e-6)
+				is.InDelta(4.0/11, s.EffectiveLoadFactor, 1e-6)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.action != nil {
+				tt.action()
+			}
+			s := ht.Stats()
+			tt.want(s)
+
+			var slots int32
+			for n, count := range s.ChainLengths {
+				slots += int32(n) * count
+			}
+			is.EqualValues(s.Size+s.Tombstones, slots)
+		})
+	}
+}
+
+func Test_StatsChains(t *testing.T) {
+	is := assert.New(t)
+
+	// Keys hash to their own value, so they fill consecutive slots.
+	ht := NewHashTableWithHash[int, int](13, func(k int) uint32 { return uint32(k) })
+	for _, key := range []int{12, 13, 1, 2, 5, 6, 7, 9} {
+		is.Nil(ht.Insert(key, key))
+	}
+
+	s := ht.Stats()
+	// Slots 12, 0, 1 and 2 form one run across the end of the table.
+	is.Equal([]int32{0, 1, 0, 1, 1}, s.ChainLengths)
+	is.EqualValues(4, s.LongestChain)
+	is.Equal([]int32{0, 8}, s.ProbeLengths)
+}
+
+func Test_StatsDegenerateHash(t *testing.T) {
+	is := assert.New(t)
+
+	good := NewHashTable[int, int](0)
+	bad := NewHashTableWithHash[int, int](0, func(k int) uint32 { return uint32(k % 2) })
+	for key := 1; key <= 200; key++ {
+		is.Nil(good.Insert(key, key))
+		is.Nil(bad.Insert(key, key))
+	}
+
+	is.Less(good.Stats().MeanProbeLength(), 3.0)
+	is.Greater(bad.Stats().MeanProbeLength(), 20.0)
+}
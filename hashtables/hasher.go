@@ -0,0 +1,336 @@
+package hashtables
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"math"
+	"math/bits"
+	"reflect"
+)
+
+// Hasher supplies a table's hash functions. Hash picks the home slot and
+// Probe is an independent secondary hash, used by double hashing for the
+// probe step. Equal keys must hash equally under both.
+type Hasher[K comparable] interface {
+	Hash(K) uint32
+	Probe(K) uint32
+}
+
+var (
+	_ Hasher[string] = HashFunc[string](DefaultHash[string])
+	_ Hasher[string] = FNVHasher[string]{}
+	_ Hasher[string] = new(MapHasher[string])
+	_ Hasher[string] = new(SipHasher[string])
+	_ Hasher[string] = new(XXHasher[string])
+)
+
+func (f HashFunc[K]) Hash(key K) uint32 {
+	return f(key)
+}
+
+// Probe derives the secondary hash from f with Mix.
+func (f HashFunc[K]) Probe(key K) uint32 {
+	return Mix(f(key))
+}
+
+// FNVHasher is the unseeded default: FNV-32 for Hash and FNV-32a for Probe,
+// both over the key's string form. Keys can be crafted to collide, so use a
+// seeded hasher for keys that come from untrusted input.
+type FNVHasher[K comparable] struct{}
+
+func (FNVHasher[K]) Hash(key K) uint32 {
+	return DefaultHash(key)
+}
+
+func (FNVHasher[K]) Probe(key K) uint32 {
+	return DefaultProbe(key)
+}
+
+// MapHasher uses hash/maphash with random per-instance seeds.
+type MapHasher[K comparable] struct {
+	hashSeed  maphash.Seed
+	probeSeed maphash.Seed
+}
+
+func NewMapHasher[K comparable]() *MapHasher[K] {
+	return &MapHasher[K]{
+		hashSeed:  maphash.MakeSeed(),
+		probeSeed: maphash.MakeSeed(),
+	}
+}
+
+func (m *MapHasher[K]) Hash(key K) uint32 {
+	return m.sum(m.hashSeed, key)
+}
+
+func (m *MapHasher[K]) Probe(key K) uint32 {
+	return m.sum(m.probeSeed, key)
+}
+
+func (m *MapHasher[K]) sum(seed maphash.Seed, key K) uint32 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	h.Write(keyBytes(key))
+	return fold(h.Sum64())
+}
+
+// SipHasher uses SipHash-2-4 keyed with a 128-bit secret, which makes
+// collisions impractical to precompute.
+type SipHasher[K comparable] struct {
+	k0, k1 uint64
+}
+
+func NewSipHasher[K comparable](k0, k1 uint64) *SipHasher[K] {
+	return &SipHasher[K]{k0: k0, k1: k1}
+}
+
+func (s *SipHasher[K]) Hash(key K) uint32 {
+	return fold(sipHash(s.k0, s.k1, keyBytes(key)))
+}
+
+func (s *SipHasher[K]) Probe(key K) uint32 {
+	return fold(sipHash(s.k1, s.k0^0x9e3779b97f4a7c15, keyBytes(key)))
+}
+
+// XXHasher uses seeded xxHash64. It is faster than SipHash on long keys but
+// is not a keyed PRF, so it resists accidental rather than adversarial
+// collisions unless the seed stays secret.
+type XXHasher[K comparable] struct {
+	seed uint64
+}
+
+func NewXXHasher[K comparable](seed uint64) *XXHasher[K] {
+	return &XXHasher[K]{seed: seed}
+}
+
+func (x *XXHasher[K]) Hash(key K) uint32 {
+	return fold(xxHash64(keyBytes(key), x.seed))
+}
+
+func (x *XXHasher[K]) Probe(key K) uint32 {
+	return fold(xxHash64(keyBytes(key), x.seed^0x9e3779b97f4a7c15))
+}
+
+// RandomSeed returns a seed from crypto/rand for the seeded hashers.
+func RandomSeed() (uint64, error) {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return 0, fmt.Errorf("unable to read random seed: %w", err)
+	}
+	return binary.LittleEndian.Uint64(b[:]), nil
+}
+
+// keyBytes encodes numeric keys as fixed-width integers rather than decimal
+// strings, which is cheaper than data.ToString.
+func keyBytes[K comparable](key K) []byte {
+	var b [8]byte
+	switch k := any(key).(type) {
+	case string:
+		return []byte(k)
+	case int:
+		binary.LittleEndian.PutUint64(b[:], uint64(k))
+	case int8:
+		binary.LittleEndian.PutUint64(b[:], uint64(k))
+	case int16:
+		binary.LittleEndian.PutUint64(b[:], uint64(k))
+	case int32:
+		binary.LittleEndian.PutUint64(b[:], uint64(k))
+	case int64:
+		binary.LittleEndian.PutUint64(b[:], uint64(k))
+	case uint:
+		binary.LittleEndian.PutUint64(b[:], uint64(k))
+	case uint8:
+		binary.LittleEndian.PutUint64(b[:], uint64(k))
+	case uint16:
+		binary.LittleEndian.PutUint64(b[:], uint64(k))
+	case uint32:
+		binary.LittleEndian.PutUint64(b[:], uint64(k))
+	case uint64:
+		binary.LittleEndian.PutUint64(b[:], k)
+	case float32:
+		binary.LittleEndian.PutUint64(b[:], floatBits(float64(k)))
+	case float64:
+		binary.LittleEndian.PutUint64(b[:], floatBits(k))
+	default:
+		return appendCanonical(nil, reflect.ValueOf(any(key)))
+	}
+	return b[:]
+}
+
+// appendCanonical encodes any comparable value so that values which compare
+// equal encode equally: floats at any depth have -0 mapped to 0, and
+// pointers and channels are encoded by address.
+func appendCanonical(b []byte, v reflect.Value) []byte {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return append(b, 1)
+		}
+		return append(b, 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return binary.LittleEndian.AppendUint64(b, uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return binary.LittleEndian.AppendUint64(b, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return binary.LittleEndian.AppendUint64(b, floatBits(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		b = binary.LittleEndian.AppendUint64(b, floatBits(real(c)))
+		return binary.LittleEndian.AppendUint64(b, floatBits(imag(c)))
+	case reflect.String:
+		b = binary.LittleEndian.AppendUint64(b, uint64(v.Len()))
+		return append(b, v.String()...)
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		return binary.LittleEndian.AppendUint64(b, uint64(v.Pointer()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			b = appendCanonical(b, v.Index(i))
+		}
+		return b
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			b = appendCanonical(b, v.Field(i))
+		}
+		return b
+	case reflect.Interface:
+		if v.IsNil() {
+			return append(b, 0)
+		}
+		b = append(b, 1)
+		b = append(b, v.Elem().Type().String()...)
+		return appendCanonical(b, v.Elem())
+	default:
+		// Only a nil interface key is left.
+		return b
+	}
+}
+
+// floatBits maps -0 to 0 so that keys which compare equal hash equally.
+func floatBits(f float64) uint64 {
+	if f == 0 {
+		return 0
+	}
+	return math.Float64bits(f)
+}
+
+func fold(h uint64) uint32 {
+	return uint32(h) ^ uint32(h>>32)
+}
+
+func sipHash(k0, k1 uint64, p []byte) uint64 {
+	v0 := k0 ^ 0x736f6d6570736575
+	v1 := k1 ^ 0x646f72616e646f6d
+	v2 := k0 ^ 0x6c7967656e657261
+	v3 := k1 ^ 0x7465646279746573
+
+	round := func() {
+		v0 += v1
+		v1 = bits.RotateLeft64(v1, 13)
+		v1 ^= v0
+		v0 = bits.RotateLeft64(v0, 32)
+		v2 += v3
+		v3 = bits.RotateLeft64(v3, 16)
+		v3 ^= v2
+		v0 += v3
+		v3 = bits.RotateLeft64(v3, 21)
+		v3 ^= v0
+		v2 += v1
+		v1 = bits.RotateLeft64(v1, 17)
+		v1 ^= v2
+		v2 = bits.RotateLeft64(v2, 32)
+	}
+
+	last := uint64(len(p)) << 56
+	for ; len(p) >= 8; p = p[8:] {
+		m := binary.LittleEndian.Uint64(p)
+		v3 ^= m
+		round()
+		round()
+		v0 ^= m
+	}
+	for i, b := range p {
+		last |= uint64(b) << (8 * i)
+	}
+
+	v3 ^= last
+	round()
+	round()
+	v0 ^= last
+
+	v2 ^= 0xff
+	round()
+	round()
+	round()
+	round()
+	return v0 ^ v1 ^ v2 ^ v3
+}
+
+const (
+	xxPrime1 uint64 = 11400714785074694791
+	xxPrime2 uint64 = 14029467366897019727
+	xxPrime3 uint64 = 1609587929392839161
+	xxPrime4 uint64 = 9650029242287828579
+	xxPrime5 uint64 = 2870177450012600261
+)
+
+func xxHash64(p []byte, seed uint64) uint64 {
+	n := len(p)
+	var h uint64
+
+	if n >= 32 {
+		v1 := seed + xxPrime1
+		v1 += xxPrime2
+		v2 := seed + xxPrime2
+		v3 := seed
+		v4 := seed - xxPrime1
+		for ; len(p) >= 32; p = p[32:] {
+			v1 = xxRound(v1, binary.LittleEndian.Uint64(p[0:]))
+			v2 = xxRound(v2, binary.LittleEndian.Uint64(p[8:]))
+			v3 = xxRound(v3, binary.LittleEndian.Uint64(p[16:]))
+			v4 = xxRound(v4, binary.LittleEndian.Uint64(p[24:]))
+		}
+		h = bits.RotateLeft64(v1, 1) + bits.RotateLeft64(v2, 7) +
+			bits.RotateLeft64(v3, 12) + bits.RotateLeft64(v4, 18)
+		h = xxMerge(h, v1)
+		h = xxMerge(h, v2)
+		h = xxMerge(h, v3)
+		h = xxMerge(h, v4)
+	} else {
+		h = seed + xxPrime5
+	}
+
+	h += uint64(n)
+	for ; len(p) >= 8; p = p[8:] {
+		h ^= xxRound(0, binary.LittleEndian.Uint64(p))
+		h = bits.RotateLeft64(h, 27)*xxPrime1 + xxPrime4
+	}
+	if len(p) >= 4 {
+		h ^= uint64(binary.LittleEndian.Uint32(p)) * xxPrime1
+		h = bits.RotateLeft64(h, 23)*xxPrime2 + xxPrime3
+		p = p[4:]
+	}
+	for _, b := range p {
+		h ^= uint64(b) * xxPrime5
+		h = bits.RotateLeft64(h, 11) * xxPrime1
+	}
+
+	h ^= h >> 33
+	h *= xxPrime2
+	h ^= h >> 29
+	h *= xxPrime3
+	h ^= h >> 32
+	return h
+}
+
+func xxRound(acc, input uint64) uint64 {
+	acc += input * xxPrime2
+	acc = bits.RotateLeft64(acc, 31)
+	return acc * xxPrime1
+}
+
+func xxMerge(acc, val uint64) uint64 {
+	acc ^= xxRound(0, val)
+	return acc*xxPrime1 + xxPrime4
+}
@@ -0,0 +1,143 @@
+package hashtables_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	linearprobing "github.com/OladapoAjala/datastructures/hashtables/linear_probing"
+	"github.com/stretchr/testify/assert"
+)
+
+func fold(h uint64) uint32 {
+	return uint32(h) ^ uint32(h>>32)
+}
+
+func Test_SipHasher(t *testing.T) {
+	is := assert.New(t)
+
+	// Test vector from the SipHash paper: key 00..0f, message 00..0e.
+	msg := make([]byte, 15)
+	for i := range msg {
+		msg[i] = byte(i)
+	}
+	sip := hashtables.NewSipHasher[string](0x0706050403020100, 0x0f0e0d0c0b0a0908)
+	is.Equal(fold(0xa129ca6149be45e5), sip.Hash(string(msg)))
+
+	other := hashtables.NewSipHasher[string](1, 2)
+	is.NotEqual(sip.Hash("key1"), other.Hash("key1"))
+	is.NotEqual(sip.Hash("key1"), sip.Probe("key1"))
+}
+
+func Test_XXHasher(t *testing.T) {
+	is := assert.New(t)
+
+	xx := hashtables.NewXXHasher[string](0)
+	tests := map[string]uint64{
+		"":    0xef46db3751d8e999,
+		"abc": 0x44bc2cf5ad770999,
+		"Nobody inspects the spammish repetition": 0xfbcea83c8a378bf1,
+	}
+	for input, want := range tests {
+		is.Equal(fold(want), xx.Hash(input), input)
+	}
+
+	is.NotEqual(xx.Hash("key1"), hashtables.NewXXHasher[string](1).Hash("key1"))
+	is.NotEqual(xx.Hash("key1"), xx.Probe("key1"))
+}
+
+func Test_Hashers(t *testing.T) {
+	is := assert.New(t)
+
+	type key struct {
+		a int
+		b string
+	}
+
+	seed, err := hashtables.RandomSeed()
+	is.Nil(err)
+
+	hashers := map[string]hashtables.Hasher[key]{
+		"fnv":     hashtables.FNVHasher[key]{},
+		"func":    hashtables.HashFunc[key](hashtables.DefaultHash[key]),
+		"maphash": hashtables.NewMapHasher[key](),
+		"siphash": hashtables.NewSipHasher[key](seed, ^seed),
+		"xxhash":  hashtables.NewXXHasher[key](seed),
+	}
+	for name, h := range hashers {
+		is.Equal(h.Hash(key{1, "a"}), h.Hash(key{1, "a"}), name)
+		is.Equal(h.Probe(key{1, "a"}), h.Probe(key{1, "a"}), name)
+		is.NotEqual(h.Hash(key{1, "a"}), h.Hash(key{1, "b"}), name)
+	}
+
+	negativeZero := math.Copysign(0, -1)
+	floats := hashtables.NewSipHasher[float64](seed, ^seed)
+	is.Equal(floats.Hash(0.5), floats.Hash(0.5))
+	is.Equal(floats.Hash(0), floats.Hash(negativeZero))
+	is.Equal(hashtables.DefaultHash(0.0), hashtables.DefaultHash(negativeZero))
+
+	// -0 == 0, so keys holding either must hash equally however deeply the
+	// float is nested.
+	type point struct {
+		x, y float64
+		z    [1]float32
+	}
+	zero := point{0, 1, [1]float32{0}}
+	signed := point{negativeZero, 1, [1]float32{float32(negativeZero)}}
+	points := map[string]hashtables.Hasher[point]{
+		"fnv":     hashtables.FNVHasher[point]{},
+		"maphash": hashtables.NewMapHasher[point](),
+		"siphash": hashtables.NewSipHasher[point](seed, ^seed),
+		"xxhash":  hashtables.NewXXHasher[point](seed),
+	}
+	is.True(zero == signed)
+	for name, h := range points {
+		is.Equal(h.Hash(zero), h.Hash(signed), name)
+		is.Equal(h.Probe(zero), h.Probe(signed), name)
+		is.NotEqual(h.Hash(zero), h.Hash(point{0, 2, [1]float32{0}}), name)
+	}
+
+	ints := hashtables.NewMapHasher[int]()
+	is.NotEqual(ints.Hash(1), ints.Hash(2))
+	is.NotEqual(ints.Hash(1), hashtables.NewMapHasher[int]().Hash(1))
+}
+
+// Test_HashFlooding builds keys that share a home slot under the unseeded
+// FNV hash and checks that a seeded hasher spreads them out again.
+func Test_HashFlooding(t *testing.T) {
+	is := assert.New(t)
+
+	const capacity, n = 1021, 100
+	keys := make([]string, 0, n)
+	for i := 0; len(keys) < n; i++ {
+		key := fmt.Sprintf("key%d", i)
+		if hashtables.DefaultHash(key)%capacity == 0 {
+			keys = append(keys, key)
+		}
+	}
+
+	longest := func(ht *linearprobing.HashTable[string, int]) int32 {
+		for i, key := range keys {
+			is.Nil(ht.Insert(key, i))
+		}
+		var max int32
+		for _, key := range keys {
+			probes, err := ht.ProbeLength(key)
+			is.Nil(err)
+			if probes > max {
+				max = probes
+			}
+		}
+		return max
+	}
+
+	flooded := longest(linearprobing.NewHashTable[string, int](capacity))
+	is.EqualValues(n, flooded)
+
+	seed, err := hashtables.RandomSeed()
+	is.Nil(err)
+	seeded := longest(linearprobing.NewHashTableWithHasher[string, int](capacity,
+		hashtables.NewSipHasher[string](seed, ^seed)))
+	is.Less(seeded, int32(n/4))
+}
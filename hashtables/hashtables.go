@@ -1,13 +1,203 @@
 package hashtables
 
 import (
-	"golang.org/x/exp/constraints"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+
+	"github.com/OladapoAjala/datastructures/sets/data"
+)
+
+var (
+	// ErrInvalidKey is returned for the zero key, which tables reserve.
+	ErrInvalidKey = errors.New("invalid key")
+	// ErrNotFound is wrapped by errors for keys that are not in the table.
+	ErrNotFound = errors.New("not found in hashtable")
+	// ErrConcurrentModification is reported when a table gains or loses keys
+	// while it is being iterated.
+	ErrConcurrentModification = errors.New("hashtable modified during iteration")
+	// ErrInvalidConfig is returned by constructors given unusable load
+	// factors.
+	ErrInvalidConfig = errors.New("invalid hashtable config")
 )
 
-type HashTabler[K constraints.Ordered] interface {
-	Insert(K, any) error
-	Find(K) (any, error)
+// Config tunes a table's hashing and resizing. Zero fields take the table's
+// defaults.
+type Config[K comparable] struct {
+	Hasher Hasher[K]
+	// MaxLoadFactor is the load at which the table grows.
+	MaxLoadFactor float32
+	// MinLoadFactor is the load below which a delete shrinks the table to
+	// half its capacity. It must be less than half of MaxLoadFactor. Zero
+	// never shrinks.
+	MinLoadFactor float32
+	// Incremental spreads each resize over the following inserts and
+	// deletes, a few slots at a time, instead of rehashing every entry in
+	// one call. Lookups consult both the old and new tables until the
+	// migration finishes.
+	Incremental bool
+}
+
+type HashTabler[K comparable, V any] interface {
+	// Insert stores value under key, overwriting any existing value.
+	Insert(K, V) error
+	Find(K) (V, error)
 	Delete(K) error
+	// Upsert is Insert that also returns the replaced value and whether
+	// there was one.
+	Upsert(K, V) (V, bool, error)
+	// GetOrInsert returns the value stored under key, storing value first if
+	// the key is absent. The bool reports whether the key was already present.
+	GetOrInsert(K, V) (V, bool, error)
+	// Compute passes the key's current value, and whether it exists, to f
+	// and stores what f returns. If f returns false the key is deleted
+	// instead. Compute returns the stored value.
+	Compute(K, func(old V, ok bool) (V, bool)) (V, error)
+	// Update overwrites the value of an existing key and returns ErrNotFound
+	// if the key is absent.
+	Update(K, V) error
 	GetSize() int32
 	GetCapacity() int32
 }
+
+// NotFound wraps ErrNotFound with the missing key.
+func NotFound[K comparable](key K) error {
+	return fmt.Errorf("key %v %w", key, ErrNotFound)
+}
+
+// Iterator walks a table's entries. Next returns false when the entries are
+// exhausted or when the table was structurally modified after the iterator
+// was created; Err tells the two apart.
+type Iterator[K comparable, V any] interface {
+	Next() bool
+	Key() K
+	Value() V
+	Err() error
+}
+
+// Ranger is implemented by tables whose entries can be visited in place.
+type Ranger[K comparable, V any] interface {
+	Range(func(K, V) bool)
+	Keys() []K
+	Values() []V
+}
+
+// Prober is implemented by tables that can report how many slots or chain
+// entries a lookup of the key inspects.
+type Prober[K comparable] interface {
+	ProbeLength(K) (int32, error)
+}
+
+// Stats describes how a table's entries are laid out, to tell tombstone
+// build-up, clustering and poor hashing apart. The histograms are indexed by
+// length: ProbeLengths[n] counts the keys found after inspecting n slots or
+// chain entries, and ChainLengths[n] the chains of length n.
+type Stats struct {
+	Size       int32
+	Capacity   int32
+	Tombstones int32
+	// LoadFactor is Size/Capacity. EffectiveLoadFactor is the load lookups
+	// actually see, which each table defines for its layout.
+	LoadFactor          float32
+	EffectiveLoadFactor float32
+	ProbeLengths        []int32
+	ChainLengths        []int32
+	LongestChain        int32
+}
+
+// AddProbe records a key found after n probes.
+func (s *Stats) AddProbe(n int32) {
+	s.ProbeLengths = addCount(s.ProbeLengths, n)
+}
+
+// AddChain records a chain of length n.
+func (s *Stats) AddChain(n int32) {
+	s.ChainLengths = addCount(s.ChainLengths, n)
+	if n > s.LongestChain {
+		s.LongestChain = n
+	}
+}
+
+// MeanProbeLength is the average number of probes over every key.
+func (s Stats) MeanProbeLength() float64 {
+	var keys, probes int64
+	for n, count := range s.ProbeLengths {
+		keys += int64(count)
+		probes += int64(n) * int64(count)
+	}
+	if keys == 0 {
+		return 0
+	}
+	return float64(probes) / float64(keys)
+}
+
+// MaxProbeLength is the most probes any key needs.
+func (s Stats) MaxProbeLength() int32 {
+	for n := len(s.ProbeLengths) - 1; n >= 0; n-- {
+		if s.ProbeLengths[n] > 0 {
+			return int32(n)
+		}
+	}
+	return 0
+}
+
+func addCount(histogram []int32, n int32) []int32 {
+	for int32(len(histogram)) <= n {
+		histogram = append(histogram, 0)
+	}
+	histogram[n]++
+	return histogram
+}
+
+// HashFunc maps a key to a 32-bit hash. Equal keys must hash equally.
+type HashFunc[K comparable] func(K) uint32
+
+// DefaultHash is FNV-32 over the key's string form. For the basic types this
+// is data.ToString(key), with -0 written as 0; any other comparable key
+// (structs, arrays, pointers) is encoded field by field in the same way, so
+// that keys which compare equal hash equally.
+func DefaultHash[K comparable](key K) uint32 {
+	hasher := fnv.New32()
+	hasher.Write([]byte(keyString(key)))
+	return hasher.Sum32()
+}
+
+// DefaultProbe is FNV-32a over the same string as DefaultHash. Double
+// hashing uses it as its secondary hash.
+func DefaultProbe[K comparable](key K) uint32 {
+	hasher := fnv.New32a()
+	hasher.Write([]byte(keyString(key)))
+	return hasher.Sum32()
+}
+
+// Mix scrambles a hash with the murmur3 finaliser. It derives a secondary
+// hash from a primary one when only a single HashFunc is supplied.
+func Mix(h uint32) uint32 {
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
+
+func keyString[K comparable](key K) string {
+	switch k := any(key).(type) {
+	case float32:
+		if k == 0 {
+			return "0"
+		}
+		return data.ToString(k)
+	case float64:
+		if k == 0 {
+			return "0"
+		}
+		return data.ToString(k)
+	case int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64, string:
+		return data.ToString(key)
+	default:
+		return string(appendCanonical(nil, reflect.ValueOf(any(key))))
+	}
+}
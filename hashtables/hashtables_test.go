@@ -0,0 +1,60 @@
+package hashtables
+
+import (
+	"hash/fnv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DefaultHash(t *testing.T) {
+	is := assert.New(t)
+
+	fnv32 := func(s string) uint32 {
+		hasher := fnv.New32()
+		hasher.Write([]byte(s))
+		return hasher.Sum32()
+	}
+
+	type key struct {
+		a int
+		b string
+	}
+
+	is.Equal(fnv32("key1"), DefaultHash("key1"))
+	is.Equal(fnv32("42"), DefaultHash(42))
+	is.Equal(fnv32("2.5"), DefaultHash(2.5))
+	is.Equal(DefaultHash(key{1, "a"}), DefaultHash(key{1, "a"}))
+	is.NotEqual(DefaultHash(key{1, "a"}), DefaultHash(key{1, "b"}))
+	is.Equal(DefaultHash([2]int{1, 2}), DefaultHash([2]int{1, 2}))
+	is.NotEqual(DefaultHash("key1"), DefaultProbe("key1"))
+}
+
+func Test_Mix(t *testing.T) {
+	is := assert.New(t)
+
+	is.EqualValues(0, Mix(0))
+	is.NotEqual(Mix(1), Mix(2))
+	is.NotEqual(uint32(1), Mix(1))
+}
+
+func Test_Stats(t *testing.T) {
+	is := assert.New(t)
+
+	var s Stats
+	is.Zero(s.MeanProbeLength())
+	is.Zero(s.MaxProbeLength())
+
+	s.AddProbe(1)
+	s.AddProbe(1)
+	s.AddProbe(4)
+	s.AddChain(0)
+	s.AddChain(3)
+	s.AddChain(2)
+
+	is.Equal([]int32{0, 2, 0, 0, 1}, s.ProbeLengths)
+	is.Equal([]int32{1, 0, 1, 1}, s.ChainLengths)
+	is.EqualValues(3, s.LongestChain)
+	is.EqualValues(4, s.MaxProbeLength())
+	is.Equal(2.0, s.MeanProbeLength())
+}
@@ -0,0 +1,19 @@
+package linearprobing
+
+import "github.com/OladapoAjala/datastructures/hashtables"
+
+func (h *HashTable[K, V]) Upsert(key K, value V) (V, bool, error) {
+	return hashtables.Upsert[K, V](h, key, value)
+}
+
+func (h *HashTable[K, V]) GetOrInsert(key K, value V) (V, bool, error) {
+	return hashtables.GetOrInsert[K, V](h, key, value)
+}
+
+func (h *HashTable[K, V]) Compute(key K, f func(old V, ok bool) (V, bool)) (V, error) {
+	return hashtables.Compute[K, V](h, key, f)
+}
+
+func (h *HashTable[K, V]) Update(key K, value V) error {
+	return hashtables.Update[K, V](h, key, value)
+}
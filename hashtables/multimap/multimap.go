@@ -0,0 +1,136 @@
+package multimap
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	seperatechaining "github.com/OladapoAjala/datastructures/hashtables/seperate_chaining"
+	"github.com/OladapoAjala/datastructures/sequences/linkedlist"
+)
+
+const DEFAULT_CAPACITY = 16
+
+// MultiMap maps each key to a list of values in insertion order. The same
+// value may be stored under a key more than once. A key is present only
+// while it has at least one value.
+type MultiMap[K comparable, V comparable] struct {
+	table *seperatechaining.HashTable[K, *linkedlist.LinkedList[V]]
+	size  int32
+}
+
+func NewMultiMap[K comparable, V comparable](capacity int32) *MultiMap[K, V] {
+	return NewMultiMapWithHasher[K, V](capacity, hashtables.FNVHasher[K]{})
+}
+
+func NewMultiMapWithHasher[K comparable, V comparable](capacity int32, hasher hashtables.Hasher[K]) *MultiMap[K, V] {
+	if capacity == 0 {
+		capacity = DEFAULT_CAPACITY
+	}
+	return &MultiMap[K, V]{
+		table: seperatechaining.NewHashTableWithHasher[K, *linkedlist.LinkedList[V]](capacity, hasher),
+	}
+}
+
+// Insert appends value to the values of key.
+func (m *MultiMap[K, V]) Insert(key K, value V) error {
+	values, err := m.table.Find(key)
+	if errors.Is(err, hashtables.ErrNotFound) {
+		values = linkedlist.NewList[V]()
+		err = m.table.Insert(key, values)
+	}
+	if err != nil {
+		return err
+	}
+	if err := values.InsertLast(value); err != nil {
+		return err
+	}
+	m.size++
+	return nil
+}
+
+// Find returns the values of key in insertion order.
+func (m *MultiMap[K, V]) Find(key K) ([]V, error) {
+	values, err := m.table.Find(key)
+	if err != nil {
+		return nil, err
+	}
+	return values.ToArray()
+}
+
+func (m *MultiMap[K, V]) Contains(key K, value V) bool {
+	values, err := m.table.Find(key)
+	return err == nil && values.Contains(value)
+}
+
+// Count returns the number of values stored under key.
+func (m *MultiMap[K, V]) Count(key K) int32 {
+	values, err := m.table.Find(key)
+	if err != nil {
+		return 0
+	}
+	return values.GetSize()
+}
+
+// Remove deletes the first occurrence of value under key.
+func (m *MultiMap[K, V]) Remove(key K, value V) error {
+	values, err := m.table.Find(key)
+	if err != nil {
+		return err
+	}
+	for n := values.Head; n != nil; n = n.Next {
+		if n.Data != value {
+			continue
+		}
+		if err := values.DeleteNode(n); err != nil {
+			return err
+		}
+		m.size--
+		if values.IsEmpty() {
+			return m.table.Delete(key)
+		}
+		return nil
+	}
+	return fmt.Errorf("value %v of %w", value, hashtables.NotFound(key))
+}
+
+// RemoveAll deletes key with all of its values and returns how many values
+// there were.
+func (m *MultiMap[K, V]) RemoveAll(key K) (int32, error) {
+	values, err := m.table.Find(key)
+	if err != nil {
+		return 0, err
+	}
+	if err := m.table.Delete(key); err != nil {
+		return 0, err
+	}
+	m.size -= values.GetSize()
+	return values.GetSize(), nil
+}
+
+// Range calls f for every key and value pair, stopping when f returns false.
+// The values of a key are visited in insertion order.
+func (m *MultiMap[K, V]) Range(f func(K, V) bool) {
+	m.table.Range(func(key K, values *linkedlist.LinkedList[V]) bool {
+		for n := values.Head; n != nil; n = n.Next {
+			if !f(key, n.Data) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func (m *MultiMap[K, V]) Keys() []K {
+	return m.table.Keys()
+}
+
+// GetSize returns the number of key and value pairs.
+func (m *MultiMap[K, V]) GetSize() int32 {
+	return m.size
+}
+
+// GetKeyCount returns the number of distinct keys.
+func (m *MultiMap[K, V]) GetKeyCount() int32 {
+	return m.table.GetSize()
+}
@@ -0,0 +1,135 @@
+package multimap
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MultiMap(t *testing.T) {
+	is := assert.New(t)
+	m := NewMultiMap[string, int](0)
+
+	tests := []struct {
+		name   string
+		action func()
+		want   func()
+	}{
+		{
+			name: "insert several values under a key",
+			action: func() {
+				is.Nil(m.Insert("a", 1))
+				is.Nil(m.Insert("a", 2))
+				is.Nil(m.Insert("a", 1))
+				is.Nil(m.Insert("b", 3))
+			},
+			want: func() {
+				values, err := m.Find("a")
+				is.Nil(err)
+				is.Equal([]int{1, 2, 1}, values)
+				is.EqualValues(3, m.Count("a"))
+				is.EqualValues(1, m.Count("b"))
+				is.EqualValues(4, m.GetSize())
+				is.EqualValues(2, m.GetKeyCount())
+				is.True(m.Contains("a", 2))
+				is.False(m.Contains("b", 2))
+			},
+		},
+		{
+			name: "insert invalid key",
+			action: func() {
+				is.ErrorIs(m.Insert("", 1), hashtables.ErrInvalidKey)
+			},
+			want: func() {
+				is.EqualValues(4, m.GetSize())
+			},
+		},
+		{
+			name: "remove the first occurrence of a value",
+			action: func() {
+				is.Nil(m.Remove("a", 1))
+			},
+			want: func() {
+				values, err := m.Find("a")
+				is.Nil(err)
+				is.Equal([]int{2, 1}, values)
+				is.EqualValues(3, m.GetSize())
+			},
+		},
+		{
+			name: "remove a missing value",
+			action: func() {
+				is.ErrorIs(m.Remove("a", 9), hashtables.ErrNotFound)
+				is.ErrorIs(m.Remove("z", 1), hashtables.ErrNotFound)
+			},
+			want: func() {
+				is.EqualValues(3, m.GetSize())
+			},
+		},
+		{
+			name: "removing the last value removes the key",
+			action: func() {
+				is.Nil(m.Remove("b", 3))
+			},
+			want: func() {
+				_, err := m.Find("b")
+				is.ErrorIs(err, hashtables.ErrNotFound)
+				is.Zero(m.Count("b"))
+				is.EqualValues(1, m.GetKeyCount())
+				is.Equal([]string{"a"}, m.Keys())
+			},
+		},
+		{
+			name: "remove all values of a key",
+			action: func() {
+				count, err := m.RemoveAll("a")
+				is.Nil(err)
+				is.EqualValues(2, count)
+				_, err = m.RemoveAll("a")
+				is.ErrorIs(err, hashtables.ErrNotFound)
+			},
+			want: func() {
+				is.Zero(m.GetSize())
+				is.Zero(m.GetKeyCount())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.action()
+			tt.want()
+		})
+	}
+}
+
+func Test_Range(t *testing.T) {
+	is := assert.New(t)
+	m := NewMultiMapWithHasher[int, int](0, hashtables.NewSipHasher[int](1, 2))
+	for key := 1; key <= 10; key++ {
+		for value := 0; value < key; value++ {
+			is.Nil(m.Insert(key, value))
+		}
+	}
+	is.EqualValues(55, m.GetSize())
+
+	seen := map[int][]int{}
+	m.Range(func(key, value int) bool {
+		seen[key] = append(seen[key], value)
+		return true
+	})
+	is.Len(seen, 10)
+	for key, values := range seen {
+		is.Len(values, key)
+		is.True(sort.IntsAreSorted(values))
+	}
+
+	visited := 0
+	m.Range(func(int, int) bool {
+		visited++
+		return visited < 3
+	})
+	is.Equal(3, visited)
+}
@@ -0,0 +1,32 @@
+package persistent
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+// Codec converts keys or values to and from bytes. A key codec must encode
+// equal keys to equal bytes, since stored keys are compared in encoded
+// form.
+type Codec[T any] interface {
+	Encode(T) ([]byte, error)
+	Decode([]byte) (T, error)
+}
+
+// GobCodec encodes with encoding/gob. It is deterministic for the basic
+// types, structs and arrays, but not for maps.
+type GobCodec[T any] struct{}
+
+func (GobCodec[T]) Encode(v T) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (GobCodec[T]) Decode(b []byte) (T, error) {
+	var v T
+	err := gob.NewDecoder(bytes.NewReader(b)).Decode(&v)
+	return v, err
+}
@@ -0,0 +1,105 @@
+package persistent
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+const (
+	PAGE_SIZE = 4096
+	// MAX_RECORD is the largest encoded key and value, together, that fits
+	// in a page.
+	MAX_RECORD = PAGE_SIZE - pageHeader - recordHeader
+
+	pageHeader   = 8
+	recordHeader = 4
+)
+
+// page is one PAGE_SIZE block of the data file. Page 0 is the file header;
+// pages 1 to buckets are the heads of the bucket chains. A page starts with
+// the id of the next page in its chain and the number of bytes its records
+// take, followed by the records, each a key length, a value length, the key
+// and the value. An all-zero page is a valid empty page, so pages past the
+// end of the file read as empty.
+type page struct {
+	id  uint32
+	buf []byte
+}
+
+func newPage(id uint32) *page {
+	return &page{id: id, buf: make([]byte, PAGE_SIZE)}
+}
+
+func (p *page) next() uint32 {
+	return binary.LittleEndian.Uint32(p.buf[0:4])
+}
+
+func (p *page) setNext(id uint32) {
+	binary.LittleEndian.PutUint32(p.buf[0:4], id)
+}
+
+func (p *page) used() int {
+	return int(binary.LittleEndian.Uint16(p.buf[4:6]))
+}
+
+func (p *page) setUsed(n int) {
+	binary.LittleEndian.PutUint16(p.buf[4:6], uint16(n))
+}
+
+func (p *page) fits(key, value []byte) bool {
+	return pageHeader+p.used()+recordHeader+len(key)+len(value) <= PAGE_SIZE
+}
+
+// records calls f with the offset, key and value of each record until f
+// returns false.
+func (p *page) records(f func(off int, key, value []byte) bool) {
+	end := pageHeader + p.used()
+	for off := pageHeader; off+recordHeader <= end; {
+		klen := int(binary.LittleEndian.Uint16(p.buf[off:]))
+		vlen := int(binary.LittleEndian.Uint16(p.buf[off+2:]))
+		start := off + recordHeader
+		if start+klen+vlen > end {
+			return
+		}
+		if !f(off, p.buf[start:start+klen], p.buf[start+klen:start+klen+vlen]) {
+			return
+		}
+		off = start + klen + vlen
+	}
+}
+
+// find returns the offset and value of key's record, or -1.
+func (p *page) find(key []byte) (int, []byte) {
+	at, value := -1, []byte(nil)
+	p.records(func(off int, k, v []byte) bool {
+		if bytes.Equal(k, key) {
+			at, value = off, v
+			return false
+		}
+		return true
+	})
+	return at, value
+}
+
+func (p *page) append(key, value []byte) {
+	off := pageHeader + p.used()
+	binary.LittleEndian.PutUint16(p.buf[off:], uint16(len(key)))
+	binary.LittleEndian.PutUint16(p.buf[off+2:], uint16(len(value)))
+	copy(p.buf[off+recordHeader:], key)
+	copy(p.buf[off+recordHeader+len(key):], value)
+	p.setUsed(p.used() + recordHeader + len(key) + len(value))
+}
+
+// remove deletes the record at off and closes the gap.
+func (p *page) remove(off int) {
+	klen := int(binary.LittleEndian.Uint16(p.buf[off:]))
+	vlen := int(binary.LittleEndian.Uint16(p.buf[off+2:]))
+	size := recordHeader + klen + vlen
+	end := pageHeader + p.used()
+
+	copy(p.buf[off:], p.buf[off+size:end])
+	for i := end - size; i < end; i++ {
+		p.buf[i] = 0
+	}
+	p.setUsed(p.used() - size)
+}
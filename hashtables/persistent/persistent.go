@@ -0,0 +1,418 @@
+package persistent
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+)
+
+var (
+	// ErrTooLarge is returned for a key and value whose encodings do not fit
+	// in a page together.
+	ErrTooLarge = errors.New("record does not fit in a page")
+	// ErrCorrupt is returned by Open for a data file it cannot read.
+	ErrCorrupt = errors.New("corrupt data file")
+	// ErrClosed is returned by every method after Close.
+	ErrClosed = errors.New("hashtable is closed")
+)
+
+const (
+	DATA_FILE = "data.db"
+	WAL_FILE  = "wal.log"
+
+	DEFAULT_BUCKETS          = 64
+	DEFAULT_CACHE_PAGES      = 64
+	DEFAULT_CHECKPOINT_BYTES = 4 << 20
+	// MIN_CACHE_PAGES leaves room for the pages a single operation holds.
+	MIN_CACHE_PAGES = 4
+	// BUCKET_RECORDS is the number of records per bucket Compact sizes the
+	// new file for.
+	BUCKET_RECORDS = 16
+)
+
+// Options configures Open. Zero fields take the defaults. Buckets only
+// applies when the data file is created; an existing file keeps its bucket
+// count until Compact resizes it. The hasher and codecs must be the same
+// every time the directory is opened.
+type Options[K comparable, V any] struct {
+	Buckets    uint32
+	CachePages int64
+	// SyncWrites syncs the log after every change. Without it a crash can
+	// lose the most recent changes, but never leaves the table inconsistent.
+	SyncWrites bool
+	// CheckpointBytes is the log size at which a write triggers Checkpoint.
+	CheckpointBytes int64
+	Hasher          hashtables.Hasher[K]
+	KeyCodec        Codec[K]
+	ValueCodec      Codec[V]
+}
+
+// HashTable is a separate chaining hash table stored in a directory. Each
+// bucket is a chain of pages in the data file, read and written through an
+// LRU page cache. Every change is appended to a write-ahead log before it
+// is applied to the pages, and Open replays the log, so a crash loses at
+// most the changes that had not reached the log. Checkpoint writes the
+// dirty pages back and empties the log.
+//
+// Buckets are never split, so chains grow with the table; Compact rewrites
+// the file with a bucket count sized for the current contents and drops
+// the space left by deleted records. HashTable is not safe for concurrent
+// use.
+type HashTable[K comparable, V any] struct {
+	dir     string
+	store   *store
+	wal     *wal
+	size    int32
+	options Options[K, V]
+	closed  bool
+	// err is the error that ended the last Range.
+	err error
+}
+
+var _ hashtables.HashTabler[string, any] = new(HashTable[string, any])
+
+// Open opens the table in dir, creating the directory and an empty table if
+// needed, and recovers any changes left in the log.
+func Open[K comparable, V any](dir string, options Options[K, V]) (*HashTable[K, V], error) {
+	if options.Buckets == 0 {
+		options.Buckets = DEFAULT_BUCKETS
+	}
+	if options.CachePages == 0 {
+		options.CachePages = DEFAULT_CACHE_PAGES
+	}
+	if options.CachePages < MIN_CACHE_PAGES {
+		options.CachePages = MIN_CACHE_PAGES
+	}
+	if options.CheckpointBytes == 0 {
+		options.CheckpointBytes = DEFAULT_CHECKPOINT_BYTES
+	}
+	if options.Hasher == nil {
+		options.Hasher = hashtables.FNVHasher[K]{}
+	}
+	if options.KeyCodec == nil {
+		options.KeyCodec = GobCodec[K]{}
+	}
+	if options.ValueCodec == nil {
+		options.ValueCodec = GobCodec[V]{}
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	// A leftover compaction file is from a Compact that crashed before its
+	// rename, so the data file is still the current one.
+	if err := os.Remove(compactPath(dir)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	s, err := openStore(filepath.Join(dir, DATA_FILE), options.Buckets, options.CachePages)
+	if err != nil {
+		return nil, err
+	}
+	w, err := openWAL(filepath.Join(dir, WAL_FILE))
+	if err != nil {
+		s.close()
+		return nil, err
+	}
+
+	h := &HashTable[K, V]{dir: dir, store: s, wal: w, options: options}
+	s.beforeWrite = h.syncWAL
+	if err := h.recover(); err != nil {
+		s.close()
+		w.close()
+		return nil, fmt.Errorf("error recovering %s: %w", dir, err)
+	}
+	return h, nil
+}
+
+// recover replays the log into the pages, counts the records and
+// checkpoints, so the table starts from an empty log.
+func (h *HashTable[K, V]) recover() error {
+	err := h.wal.replay(func(op byte, key, value []byte) error {
+		hash, err := h.hashEncoded(key)
+		if err != nil {
+			return err
+		}
+		switch op {
+		case opPut:
+			_, err = h.store.put(hash, key, value)
+		case opDelete:
+			_, err = h.store.remove(hash, key)
+		default:
+			err = fmt.Errorf("log operation %d: %w", op, ErrCorrupt)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := h.store.each(func(_, _ []byte) error {
+		h.size++
+		return nil
+	}); err != nil {
+		return err
+	}
+	return h.Checkpoint()
+}
+
+func (h *HashTable[K, V]) hashEncoded(key []byte) (uint32, error) {
+	k, err := h.options.KeyCodec.Decode(key)
+	if err != nil {
+		return 0, err
+	}
+	return h.options.Hasher.Hash(k), nil
+}
+
+func (h *HashTable[K, V]) syncWAL() error {
+	return h.wal.sync()
+}
+
+func (h *HashTable[K, V]) Insert(key K, value V) error {
+	if err := h.check(key); err != nil {
+		return err
+	}
+	_, err := h.put(key, value)
+	return err
+}
+
+// put logs and stores value under key and reports whether key was present.
+func (h *HashTable[K, V]) put(key K, value V) (bool, error) {
+	k, err := h.options.KeyCodec.Encode(key)
+	if err != nil {
+		return false, err
+	}
+	v, err := h.options.ValueCodec.Encode(value)
+	if err != nil {
+		return false, err
+	}
+	if len(k)+len(v) > MAX_RECORD {
+		return false, fmt.Errorf("key %v: %w", key, ErrTooLarge)
+	}
+
+	if err := h.wal.append(opPut, k, v, h.options.SyncWrites); err != nil {
+		return false, err
+	}
+	found, err := h.store.put(h.options.Hasher.Hash(key), k, v)
+	if err != nil {
+		return false, err
+	}
+	if !found {
+		h.size++
+	}
+	return found, h.maybeCheckpoint()
+}
+
+func (h *HashTable[K, V]) Find(key K) (V, error) {
+	if err := h.check(key); err != nil {
+		return *new(V), err
+	}
+	value, ok, err := h.get(key)
+	if err != nil {
+		return *new(V), err
+	}
+	if !ok {
+		return *new(V), hashtables.NotFound(key)
+	}
+	return value, nil
+}
+
+func (h *HashTable[K, V]) get(key K) (V, bool, error) {
+	k, err := h.options.KeyCodec.Encode(key)
+	if err != nil {
+		return *new(V), false, err
+	}
+	v, ok, err := h.store.get(h.options.Hasher.Hash(key), k)
+	if err != nil || !ok {
+		return *new(V), false, err
+	}
+	value, err := h.options.ValueCodec.Decode(v)
+	return value, err == nil, err
+}
+
+func (h *HashTable[K, V]) Delete(key K) error {
+	if err := h.check(key); err != nil {
+		return err
+	}
+	k, err := h.options.KeyCodec.Encode(key)
+	if err != nil {
+		return err
+	}
+
+	hash := h.options.Hasher.Hash(key)
+	_, ok, err := h.store.get(hash, k)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return hashtables.NotFound(key)
+	}
+
+	if err := h.wal.append(opDelete, k, nil, h.options.SyncWrites); err != nil {
+		return err
+	}
+	if _, err := h.store.remove(hash, k); err != nil {
+		return err
+	}
+	h.size--
+	return h.maybeCheckpoint()
+}
+
+func (h *HashTable[K, V]) check(key K) error {
+	if h.closed {
+		return ErrClosed
+	}
+	if key == *new(K) {
+		return hashtables.ErrInvalidKey
+	}
+	return nil
+}
+
+func (h *HashTable[K, V]) maybeCheckpoint() error {
+	if h.wal.size < h.options.CheckpointBytes {
+		return nil
+	}
+	return h.Checkpoint()
+}
+
+// Checkpoint writes every dirty page to the data file, syncs it and empties
+// the log.
+func (h *HashTable[K, V]) Checkpoint() error {
+	if h.closed {
+		return ErrClosed
+	}
+	if err := h.store.flush(); err != nil {
+		return err
+	}
+	return h.wal.reset()
+}
+
+// Compact rewrites the data file with one bucket per BUCKET_RECORDS records,
+// and no fewer than Options.Buckets, leaving out the space freed by deletes.
+// The new file is written beside the old one and renamed over it, so a crash
+// during Compact leaves the old file in place.
+func (h *HashTable[K, V]) Compact() error {
+	if err := h.Checkpoint(); err != nil {
+		return err
+	}
+
+	buckets := uint32(h.size) / BUCKET_RECORDS
+	if buckets < h.options.Buckets {
+		buckets = h.options.Buckets
+	}
+	path := compactPath(h.dir)
+	s, err := openStore(path, buckets, h.options.CachePages)
+	if err != nil {
+		return err
+	}
+
+	err = h.store.each(func(key, value []byte) error {
+		hash, err := h.hashEncoded(key)
+		if err != nil {
+			return err
+		}
+		_, err = s.put(hash, key, value)
+		return err
+	})
+	if err == nil {
+		err = s.flush()
+	}
+	if err == nil {
+		err = os.Rename(path, filepath.Join(h.dir, DATA_FILE))
+	}
+	if err == nil {
+		err = syncDir(h.dir)
+	}
+	if err != nil {
+		s.close()
+		os.Remove(path)
+		return fmt.Errorf("error compacting %s: %w", h.dir, err)
+	}
+
+	old := h.store
+	s.beforeWrite = h.syncWAL
+	h.store = s
+	return old.close()
+}
+
+func compactPath(dir string) string {
+	return filepath.Join(dir, DATA_FILE+".compact")
+}
+
+// syncDir makes a rename in dir durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+// Close checkpoints the table and closes its files.
+func (h *HashTable[K, V]) Close() error {
+	if h.closed {
+		return ErrClosed
+	}
+	err := h.Checkpoint()
+	h.closed = true
+	if cerr := h.store.close(); err == nil {
+		err = cerr
+	}
+	if cerr := h.wal.close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// Range calls f with every entry until f returns false. It stops early if a
+// page cannot be read or an entry cannot be decoded; Err reports why.
+func (h *HashTable[K, V]) Range(f func(K, V) bool) {
+	if h.closed {
+		h.err = ErrClosed
+		return
+	}
+	stop := errors.New("stop")
+	err := h.store.each(func(key, value []byte) error {
+		k, err := h.options.KeyCodec.Decode(key)
+		if err != nil {
+			return fmt.Errorf("error decoding key: %w", err)
+		}
+		v, err := h.options.ValueCodec.Decode(value)
+		if err != nil {
+			return fmt.Errorf("error decoding value of key %v: %w", k, err)
+		}
+		if !f(k, v) {
+			return stop
+		}
+		return nil
+	})
+	if err == stop {
+		err = nil
+	}
+	h.err = err
+}
+
+// Err returns the error that ended the last Range, or nil if it visited
+// every entry or f stopped it.
+func (h *HashTable[K, V]) Err() error {
+	return h.err
+}
+
+func (h *HashTable[K, V]) GetSize() int32 {
+	return h.size
+}
+
+// GetCapacity returns the number of buckets.
+func (h *HashTable[K, V]) GetCapacity() int32 {
+	return int32(h.store.buckets)
+}
+
+// GetPages returns the number of pages in the data file, including the
+// header.
+func (h *HashTable[K, V]) GetPages() uint32 {
+	return h.store.pages
+}
@@ -0,0 +1,431 @@
+package persistent
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/stretchr/testify/assert"
+)
+
+func open(t *testing.T, dir string, options Options[string, int]) *HashTable[string, int] {
+	h, err := Open(dir, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+// crash closes the files without a checkpoint, leaving dirty pages unwritten
+// as a process dying would.
+func crash(h *HashTable[string, int]) {
+	h.store.close()
+	h.wal.close()
+	h.closed = true
+}
+
+func Test_Operations(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name string
+		run  func(*HashTable[string, int])
+	}{
+		{
+			name: "insert and find",
+			run: func(h *HashTable[string, int]) {
+				is.Nil(h.Insert("one", 1))
+				is.Nil(h.Insert("two", 2))
+				is.Nil(h.Insert("one", 11))
+				v, err := h.Find("one")
+				is.Nil(err)
+				is.Equal(11, v)
+				is.EqualValues(2, h.GetSize())
+			},
+		},
+		{
+			name: "invalid and missing keys",
+			run: func(h *HashTable[string, int]) {
+				is.ErrorIs(h.Insert("", 1), hashtables.ErrInvalidKey)
+				_, err := h.Find("missing")
+				is.ErrorIs(err, hashtables.ErrNotFound)
+				is.ErrorIs(h.Delete("missing"), hashtables.ErrNotFound)
+				is.ErrorIs(h.Update("missing", 1), hashtables.ErrNotFound)
+			},
+		},
+		{
+			name: "delete",
+			run: func(h *HashTable[string, int]) {
+				is.Nil(h.Insert("one", 1))
+				is.Nil(h.Delete("one"))
+				_, err := h.Find("one")
+				is.ErrorIs(err, hashtables.ErrNotFound)
+				is.EqualValues(0, h.GetSize())
+			},
+		},
+		{
+			name: "upsert, get or insert and compute",
+			run: func(h *HashTable[string, int]) {
+				old, ok, err := h.Upsert("k", 1)
+				is.Nil(err)
+				is.False(ok)
+				old, ok, err = h.Upsert("k", 2)
+				is.Nil(err)
+				is.True(ok)
+				is.Equal(1, old)
+
+				v, ok, err := h.GetOrInsert("k", 3)
+				is.Nil(err)
+				is.True(ok)
+				is.Equal(2, v)
+
+				v, err = h.Compute("k", func(old int, ok bool) (int, bool) { return old * 10, true })
+				is.Nil(err)
+				is.Equal(20, v)
+				_, err = h.Compute("k", func(int, bool) (int, bool) { return 0, false })
+				is.Nil(err)
+				is.EqualValues(0, h.GetSize())
+			},
+		},
+		{
+			name: "record too large",
+			run: func(h *HashTable[string, int]) {
+				is.ErrorIs(h.Insert(string(make([]byte, PAGE_SIZE)), 1), ErrTooLarge)
+				is.EqualValues(0, h.GetSize())
+			},
+		},
+		{
+			name: "closed",
+			run: func(h *HashTable[string, int]) {
+				is.Nil(h.Close())
+				is.ErrorIs(h.Insert("k", 1), ErrClosed)
+				is.ErrorIs(h.Close(), ErrClosed)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := open(t, t.TempDir(), Options[string, int]{})
+			tt.run(h)
+			h.Close()
+		})
+	}
+}
+
+func Test_Reopen(t *testing.T) {
+	is := assert.New(t)
+	dir := t.TempDir()
+
+	// A small cache and few buckets force long chains and evictions of dirty
+	// pages.
+	h := open(t, dir, Options[string, int]{Buckets: 4, CachePages: 4})
+	for i := 1; i <= 3000; i++ {
+		is.Nil(h.Insert(fmt.Sprint("key", i), i))
+	}
+	for i := 1; i <= 3000; i += 3 {
+		is.Nil(h.Delete(fmt.Sprint("key", i)))
+	}
+	is.Greater(h.GetPages(), uint32(5))
+	is.Nil(h.Close())
+
+	h = open(t, dir, Options[string, int]{})
+	defer h.Close()
+	is.EqualValues(2000, h.GetSize())
+	is.EqualValues(4, h.GetCapacity())
+	for i := 1; i <= 3000; i++ {
+		v, err := h.Find(fmt.Sprint("key", i))
+		if i%3 == 1 {
+			is.ErrorIs(err, hashtables.ErrNotFound)
+		} else {
+			is.Nil(err)
+			is.Equal(i, v)
+		}
+	}
+
+	count := 0
+	h.Range(func(string, int) bool {
+		count++
+		return true
+	})
+	is.Equal(2000, count)
+}
+
+func Test_RangeError(t *testing.T) {
+	is := assert.New(t)
+	dir := t.TempDir()
+
+	h := open(t, dir, Options[string, int]{})
+	for i := 1; i <= 10; i++ {
+		is.Nil(h.Insert(fmt.Sprint("key", i), i))
+	}
+	count := 0
+	h.Range(func(string, int) bool {
+		count++
+		return count < 5
+	})
+	is.Equal(5, count)
+	is.Nil(h.Err())
+	is.Nil(h.Close())
+
+	h.Range(func(string, int) bool { return true })
+	is.ErrorIs(h.Err(), ErrClosed)
+
+	// Values written as ints do not decode as strings, so Range stops at
+	// the first entry instead of skipping them all.
+	s, err := Open(dir, Options[string, string]{})
+	is.Nil(err)
+	count = 0
+	s.Range(func(string, string) bool {
+		count++
+		return true
+	})
+	is.Equal(0, count)
+	is.Error(s.Err())
+	is.Nil(s.Close())
+}
+
+func Test_Crash(t *testing.T) {
+	is := assert.New(t)
+	r := rand.New(rand.NewSource(44))
+
+	// With a small cache, pages are written back between checkpoints, so
+	// replay runs over pages that already hold some of the logged changes.
+	for _, cachePages := range []int64{1024, MIN_CACHE_PAGES} {
+		t.Run(fmt.Sprintf("cache=%d", cachePages), func(t *testing.T) {
+			truncateLog(t, r, cachePages)
+		})
+	}
+
+	// Overflow pages the cache wrote back before the crash stay allocated,
+	// so later inserts do not overwrite them.
+	dir := t.TempDir()
+	options := Options[string, int]{Buckets: 4, CachePages: 4}
+	h := open(t, dir, options)
+	is.Nil(h.Checkpoint())
+	for i := 0; i < 3000; i++ {
+		is.Nil(h.Insert(fmt.Sprint("evicted", i), i))
+	}
+	crash(h)
+
+	h = open(t, dir, options)
+	for i := 3000; i < 5000; i++ {
+		is.Nil(h.Insert(fmt.Sprint("evicted", i), i))
+	}
+	is.Nil(h.Close())
+
+	h = open(t, dir, options)
+	is.EqualValues(5000, h.GetSize())
+	for i := 0; i < 5000; i++ {
+		v, err := h.Find(fmt.Sprint("evicted", i))
+		is.Nil(err)
+		is.Equal(i, v)
+	}
+	is.Nil(h.Close())
+}
+
+// truncateLog makes random changes on top of a checkpoint, copying the data
+// file every so often, then crashes and recovers each copy with the log cut
+// at random points. A page is only written back once the log before it is
+// synced, so no cut falls before the log size at the copy's last
+// write-back.
+func truncateLog(t *testing.T, r *rand.Rand, cachePages int64) {
+	is := assert.New(t)
+	dir := t.TempDir()
+
+	// The checkpointed state survives any crash.
+	base := make(map[string]int)
+	h := open(t, dir, Options[string, int]{Buckets: 8, CachePages: cachePages})
+	for i := 0; i < 200; i++ {
+		key := fmt.Sprint("base", i)
+		base[key] = i
+		is.Nil(h.Insert(key, i))
+	}
+	is.Nil(h.Checkpoint())
+
+	var synced int64
+	h.store.beforeWrite = func() error {
+		synced = h.wal.size
+		return h.syncWAL()
+	}
+
+	type op struct {
+		key    string
+		value  int
+		delete bool
+		end    int64
+	}
+	type snapshot struct {
+		data        []byte
+		synced, end int64
+	}
+	var ops []op
+	var snapshots []snapshot
+	for i := 1; i <= 500; i++ {
+		if i%50 == 0 {
+			data, err := os.ReadFile(filepath.Join(dir, DATA_FILE))
+			is.Nil(err)
+			snapshots = append(snapshots, snapshot{data, synced, h.wal.size})
+		}
+
+		o := op{key: fmt.Sprint("base", r.Intn(300)), value: r.Int()}
+		if r.Intn(4) == 0 {
+			o.delete = true
+			if err := h.Delete(o.key); err != nil {
+				continue
+			}
+		} else {
+			is.Nil(h.Insert(o.key, o.value))
+		}
+		o.end = h.wal.size
+		ops = append(ops, o)
+	}
+	crash(h)
+
+	data, err := os.ReadFile(filepath.Join(dir, DATA_FILE))
+	is.Nil(err)
+	snapshots = append(snapshots, snapshot{data, synced, h.wal.size})
+	log, err := os.ReadFile(filepath.Join(dir, WAL_FILE))
+	is.Nil(err)
+
+	for _, snap := range snapshots {
+		cuts := []int64{snap.synced, snap.end}
+		for i := 0; i < 2; i++ {
+			cuts = append(cuts, snap.synced+r.Int63n(snap.end-snap.synced+1))
+		}
+		for _, cut := range cuts {
+			want := make(map[string]int)
+			for k, v := range base {
+				want[k] = v
+			}
+			for _, o := range ops {
+				if o.end > cut {
+					break
+				}
+				if o.delete {
+					delete(want, o.key)
+				} else {
+					want[o.key] = o.value
+				}
+			}
+
+			crashed := t.TempDir()
+			is.Nil(os.WriteFile(filepath.Join(crashed, DATA_FILE), snap.data, 0o644))
+			is.Nil(os.WriteFile(filepath.Join(crashed, WAL_FILE), log[:cut], 0o644))
+
+			h := open(t, crashed, Options[string, int]{CachePages: cachePages})
+			is.EqualValues(len(want), h.GetSize(), "cut at %d", cut)
+			for i := 0; i < 300; i++ {
+				key := fmt.Sprint("base", i)
+				v, err := h.Find(key)
+				if w, ok := want[key]; ok {
+					is.Nil(err)
+					is.Equal(w, v, "cut at %d", cut)
+				} else {
+					is.ErrorIs(err, hashtables.ErrNotFound, "cut at %d", cut)
+				}
+			}
+			is.Nil(h.Close())
+
+			// Recovery checkpoints, leaving an empty log.
+			info, err := os.Stat(filepath.Join(crashed, WAL_FILE))
+			is.Nil(err)
+			is.EqualValues(0, info.Size())
+		}
+	}
+}
+
+func Test_CorruptRecord(t *testing.T) {
+	is := assert.New(t)
+	dir := t.TempDir()
+
+	h := open(t, dir, Options[string, int]{SyncWrites: true})
+	is.Nil(h.Insert("a", 1))
+	is.Nil(h.Insert("b", 2))
+	is.Nil(h.Insert("c", 3))
+	crash(h)
+
+	// A flipped byte in the second record ends the log there.
+	path := filepath.Join(dir, WAL_FILE)
+	log, err := os.ReadFile(path)
+	is.Nil(err)
+	log[len(log)*1/2] ^= 0xff
+	is.Nil(os.WriteFile(path, log, 0o644))
+
+	h = open(t, dir, Options[string, int]{})
+	defer h.Close()
+	is.EqualValues(1, h.GetSize())
+	v, err := h.Find("a")
+	is.Nil(err)
+	is.Equal(1, v)
+}
+
+func Test_Compact(t *testing.T) {
+	is := assert.New(t)
+	dir := t.TempDir()
+
+	h := open(t, dir, Options[string, int]{Buckets: 8})
+	for i := 1; i <= 4000; i++ {
+		is.Nil(h.Insert(fmt.Sprint("key", i), i))
+	}
+	for i := 1; i <= 4000; i++ {
+		if i%40 != 0 {
+			is.Nil(h.Delete(fmt.Sprint("key", i)))
+		}
+	}
+
+	// Deletes leave the chains as long as they were; compacting frees the
+	// pages.
+	pages := h.GetPages()
+	is.Nil(h.Compact())
+	is.EqualValues(8, h.GetCapacity())
+	is.EqualValues(9, h.GetPages())
+	is.Less(h.GetPages(), pages)
+	is.EqualValues(100, h.GetSize())
+
+	// Compacting a grown table adds buckets.
+	for i := 1; i <= 1500; i++ {
+		is.Nil(h.Insert(fmt.Sprint("more", i), i))
+	}
+	is.Nil(h.Compact())
+	is.EqualValues(1600/BUCKET_RECORDS, h.GetCapacity())
+
+	// The table stays writable and durable after the swap.
+	is.Nil(h.Insert("after", -1))
+	is.Nil(h.Close())
+	_, err := os.Stat(compactPath(dir))
+	is.ErrorIs(err, os.ErrNotExist)
+
+	h = open(t, dir, Options[string, int]{Buckets: 8})
+	defer h.Close()
+	is.EqualValues(1601, h.GetSize())
+	for i := 40; i <= 4000; i += 40 {
+		v, err := h.Find(fmt.Sprint("key", i))
+		is.Nil(err)
+		is.Equal(i, v)
+	}
+	v, err := h.Find("after")
+	is.Nil(err)
+	is.Equal(-1, v)
+}
+
+func Test_InterruptedCompact(t *testing.T) {
+	is := assert.New(t)
+	dir := t.TempDir()
+
+	h := open(t, dir, Options[string, int]{})
+	is.Nil(h.Insert("a", 1))
+	is.Nil(h.Close())
+	is.Nil(os.WriteFile(compactPath(dir), []byte("partial"), 0o644))
+
+	h = open(t, dir, Options[string, int]{})
+	defer h.Close()
+	v, err := h.Find("a")
+	is.Nil(err)
+	is.Equal(1, v)
+	_, err = os.Stat(compactPath(dir))
+	is.ErrorIs(err, os.ErrNotExist)
+}
@@ -0,0 +1,262 @@
+package persistent
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/OladapoAjala/datastructures/cache"
+)
+
+var magic = []byte("PHT1")
+
+// store is the data file seen through an LRU page cache. Modified pages are
+// kept in dirty until they are written back, either when the cache evicts
+// them or on flush. Before a dirty page is written, beforeWrite syncs the
+// write-ahead log, so the file never holds a change the log could lose, and
+// the header is brought up to date, so it never counts fewer pages than the
+// file links to.
+type store struct {
+	file    *os.File
+	buckets uint32
+	pages   uint32
+	// written is the page count last written to the header.
+	written     uint32
+	cache       *cache.LRU[uint32, *page]
+	dirty       map[uint32]*page
+	beforeWrite func() error
+	// err holds a write-back failure from the eviction callback until the
+	// next operation can return it.
+	err error
+}
+
+// openStore opens the data file at path, creating it with the given number
+// of buckets if it is empty.
+func openStore(path string, buckets uint32, cachePages int64) (*store, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &store{file: f, dirty: make(map[uint32]*page)}
+	s.cache, err = cache.NewLRU(cache.Config[uint32, *page]{
+		Capacity: cachePages,
+		OnEvict:  s.evicted,
+	})
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	header := newPage(0)
+	n, err := f.ReadAt(header.buf, 0)
+	switch {
+	case err != nil && !errors.Is(err, io.EOF):
+		f.Close()
+		return nil, err
+	case n == 0:
+		s.buckets, s.pages = buckets, buckets+1
+		return s, s.writeHeader()
+	case n < PAGE_SIZE || !bytes.Equal(header.buf[0:4], magic):
+		f.Close()
+		return nil, fmt.Errorf("%s: %w", path, ErrCorrupt)
+	}
+
+	s.buckets = binary.LittleEndian.Uint32(header.buf[4:8])
+	s.pages = binary.LittleEndian.Uint32(header.buf[8:12])
+	if s.buckets == 0 || s.pages <= s.buckets {
+		f.Close()
+		return nil, fmt.Errorf("%s: %w", path, ErrCorrupt)
+	}
+	s.written = s.pages
+
+	// Pages past the header's count can reach the file if a crash
+	// reordered the writes; never hand them out again.
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if n := uint32((info.Size() + PAGE_SIZE - 1) / PAGE_SIZE); n > s.pages {
+		s.pages = n
+	}
+	return s, nil
+}
+
+func (s *store) writeHeader() error {
+	header := newPage(0)
+	copy(header.buf[0:4], magic)
+	binary.LittleEndian.PutUint32(header.buf[4:8], s.buckets)
+	binary.LittleEndian.PutUint32(header.buf[8:12], s.pages)
+	if _, err := s.file.WriteAt(header.buf, 0); err != nil {
+		return err
+	}
+	s.written = s.pages
+	return nil
+}
+
+// page returns page id from the cache, reading it in on a miss. A dirty
+// page is returned even if the cache has evicted it since.
+func (s *store) page(id uint32) (*page, error) {
+	if p, ok := s.dirty[id]; ok {
+		return p, s.cached(p)
+	}
+	if p, err := s.cache.Get(id); err == nil {
+		return p, nil
+	}
+
+	p := newPage(id)
+	if _, err := s.file.ReadAt(p.buf, int64(id)*PAGE_SIZE); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return p, s.cached(p)
+}
+
+func (s *store) cached(p *page) error {
+	if err := s.cache.Put(p.id, p); err != nil {
+		return err
+	}
+	err := s.err
+	s.err = nil
+	return err
+}
+
+func (s *store) evicted(id uint32, p *page, _ cache.EvictReason) {
+	if _, ok := s.dirty[id]; !ok {
+		return
+	}
+	if err := s.write(p); err != nil && s.err == nil {
+		s.err = err
+	}
+}
+
+func (s *store) write(p *page) error {
+	if s.beforeWrite != nil {
+		if err := s.beforeWrite(); err != nil {
+			return err
+		}
+	}
+	if s.written != s.pages {
+		if err := s.writeHeader(); err != nil {
+			return err
+		}
+	}
+	if _, err := s.file.WriteAt(p.buf, int64(p.id)*PAGE_SIZE); err != nil {
+		return err
+	}
+	delete(s.dirty, p.id)
+	return nil
+}
+
+func (s *store) head(hash uint32) uint32 {
+	return hash%s.buckets + 1
+}
+
+// get returns a copy of the value stored under key.
+func (s *store) get(hash uint32, key []byte) ([]byte, bool, error) {
+	for id := s.head(hash); id != 0; {
+		p, err := s.page(id)
+		if err != nil {
+			return nil, false, err
+		}
+		if off, value := p.find(key); off >= 0 {
+			return append([]byte(nil), value...), true, nil
+		}
+		id = p.next()
+	}
+	return nil, false, nil
+}
+
+// put stores value under key and reports whether key was present. The
+// record goes in the first page of the chain with room for it, or in a new
+// page linked to the end of the chain.
+func (s *store) put(hash uint32, key, value []byte) (bool, error) {
+	found, err := s.remove(hash, key)
+	if err != nil {
+		return false, err
+	}
+
+	var p *page
+	for id := s.head(hash); id != 0; id = p.next() {
+		if p, err = s.page(id); err != nil {
+			return false, err
+		}
+		if p.fits(key, value) {
+			p.append(key, value)
+			s.dirty[p.id] = p
+			return found, nil
+		}
+	}
+
+	next := newPage(s.pages)
+	s.pages++
+	next.append(key, value)
+	s.dirty[next.id] = next
+	p.setNext(next.id)
+	s.dirty[p.id] = p
+	return found, s.cached(next)
+}
+
+// remove deletes key from the chain and reports whether it was present. It
+// removes every copy of the key, since a crash can leave a stale copy in a
+// page written before the one the key moved out of.
+func (s *store) remove(hash uint32, key []byte) (bool, error) {
+	found := false
+	for id := s.head(hash); id != 0; {
+		p, err := s.page(id)
+		if err != nil {
+			return false, err
+		}
+		for off, _ := p.find(key); off >= 0; off, _ = p.find(key) {
+			p.remove(off)
+			s.dirty[p.id] = p
+			found = true
+		}
+		id = p.next()
+	}
+	return found, nil
+}
+
+// each calls f with every record, bucket by bucket. The slices are only
+// valid during the call.
+func (s *store) each(f func(key, value []byte) error) error {
+	for b := uint32(1); b <= s.buckets; b++ {
+		for id := b; id != 0; {
+			p, err := s.page(id)
+			if err != nil {
+				return err
+			}
+
+			var ferr error
+			p.records(func(_ int, key, value []byte) bool {
+				ferr = f(key, value)
+				return ferr == nil
+			})
+			if ferr != nil {
+				return ferr
+			}
+			id = p.next()
+		}
+	}
+	return nil
+}
+
+// flush writes back every dirty page and the header, then syncs the file.
+func (s *store) flush() error {
+	for _, p := range s.dirty {
+		if err := s.write(p); err != nil {
+			return err
+		}
+	}
+	if err := s.writeHeader(); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+func (s *store) close() error {
+	return s.file.Close()
+}
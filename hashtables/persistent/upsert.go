@@ -0,0 +1,62 @@
+package persistent
+
+import "github.com/OladapoAjala/datastructures/hashtables"
+
+func (h *HashTable[K, V]) Upsert(key K, value V) (V, bool, error) {
+	if err := h.check(key); err != nil {
+		return *new(V), false, err
+	}
+	old, ok, err := h.get(key)
+	if err != nil {
+		return *new(V), false, err
+	}
+	_, err = h.put(key, value)
+	return old, ok, err
+}
+
+func (h *HashTable[K, V]) GetOrInsert(key K, value V) (V, bool, error) {
+	if err := h.check(key); err != nil {
+		return *new(V), false, err
+	}
+	old, ok, err := h.get(key)
+	if err != nil || ok {
+		return old, ok, err
+	}
+	_, err = h.put(key, value)
+	return value, false, err
+}
+
+func (h *HashTable[K, V]) Compute(key K, f func(old V, ok bool) (V, bool)) (V, error) {
+	if err := h.check(key); err != nil {
+		return *new(V), err
+	}
+	old, ok, err := h.get(key)
+	if err != nil {
+		return *new(V), err
+	}
+
+	value, keep := f(old, ok)
+	switch {
+	case keep:
+		_, err = h.put(key, value)
+		return value, err
+	case ok:
+		return *new(V), h.Delete(key)
+	}
+	return *new(V), nil
+}
+
+func (h *HashTable[K, V]) Update(key K, value V) error {
+	if err := h.check(key); err != nil {
+		return err
+	}
+	_, ok, err := h.get(key)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return hashtables.NotFound(key)
+	}
+	_, err = h.put(key, value)
+	return err
+}
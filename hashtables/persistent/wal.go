@@ -0,0 +1,118 @@
+package persistent
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+)
+
+const (
+	opPut byte = iota + 1
+	opDelete
+
+	walHeader = 8
+)
+
+// wal is the write-ahead log. Every change is appended as a record of a
+// CRC-32 and length followed by the operation, the key length, the key and
+// the value. Records are logical and replaying one that was already applied
+// is harmless, so recovery simply reapplies the whole log.
+type wal struct {
+	file *os.File
+	size int64
+	// unsynced is set by appends that have not been synced yet.
+	unsynced bool
+}
+
+func openWAL(path string) (*wal, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &wal{file: f}, nil
+}
+
+func (w *wal) append(op byte, key, value []byte, sync bool) error {
+	record := make([]byte, walHeader+3+len(key)+len(value))
+	payload := record[walHeader:]
+	payload[0] = op
+	binary.LittleEndian.PutUint16(payload[1:], uint16(len(key)))
+	copy(payload[3:], key)
+	copy(payload[3+len(key):], value)
+	binary.LittleEndian.PutUint32(record[0:], crc32.ChecksumIEEE(payload))
+	binary.LittleEndian.PutUint32(record[4:], uint32(len(payload)))
+
+	if _, err := w.file.WriteAt(record, w.size); err != nil {
+		return err
+	}
+	w.size += int64(len(record))
+	w.unsynced = true
+	if sync {
+		return w.sync()
+	}
+	return nil
+}
+
+func (w *wal) sync() error {
+	if !w.unsynced {
+		return nil
+	}
+	w.unsynced = false
+	return w.file.Sync()
+}
+
+// replay calls apply for each intact record. The log ends at the first
+// record that is cut short or fails its checksum, which is where a crash
+// interrupted an append, and is truncated there.
+func (w *wal) replay(apply func(op byte, key, value []byte) error) error {
+	if _, err := w.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	log, err := io.ReadAll(w.file)
+	if err != nil {
+		return err
+	}
+
+	var off int
+	for off+walHeader <= len(log) {
+		sum := binary.LittleEndian.Uint32(log[off:])
+		size := int(binary.LittleEndian.Uint32(log[off+4:]))
+		end := off + walHeader + size
+		if size < 3 || end > len(log) {
+			break
+		}
+		payload := log[off+walHeader : end]
+		klen := int(binary.LittleEndian.Uint16(payload[1:]))
+		if crc32.ChecksumIEEE(payload) != sum || 3+klen > size {
+			break
+		}
+		if err := apply(payload[0], payload[3:3+klen], payload[3+klen:]); err != nil {
+			return err
+		}
+		off = end
+	}
+
+	w.size = int64(off)
+	if off < len(log) {
+		if err := w.file.Truncate(w.size); err != nil {
+			return err
+		}
+		return w.file.Sync()
+	}
+	return nil
+}
+
+// reset empties the log once its changes are safely in the data file.
+func (w *wal) reset() error {
+	if err := w.file.Truncate(0); err != nil {
+		return err
+	}
+	w.size = 0
+	w.unsynced = false
+	return w.file.Sync()
+}
+
+func (w *wal) close() error {
+	return w.file.Close()
+}
@@ -0,0 +1,207 @@
+package robinhood
+
+import (
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/OladapoAjala/datastructures/helpers"
+	"github.com/OladapoAjala/datastructures/sets/data"
+)
+
+// HashTable is a linear-probing table where an inserted item takes the slot
+// of any resident that is closer to its home slot. This keeps probe lengths
+// short and even, so the table can run at a higher load factor. Deletion
+// uses backward shifting, so there are no tombstones.
+type HashTable[K comparable, V any] struct {
+	Table      []*data.Data[K, V]
+	capacity   int32
+	size       int32
+	loadFactor float32
+	hasher     hashtables.Hasher[K]
+}
+
+const (
+	MAX_LOAD_FACTOR  = 0.90
+	DEFAULT_CAPACITY = 7
+)
+
+type HashTabler[K comparable, V any] interface {
+	hashtables.HashTabler[K, V]
+	hashtables.Prober[K]
+	GetLoadFactor() float32
+}
+
+var _ HashTabler[string, any] = new(HashTable[string, any])
+
+func NewHashTable[K comparable, V any](capacity int32) *HashTable[K, V] {
+	return NewHashTableWithHasher[K, V](capacity, hashtables.FNVHasher[K]{})
+}
+
+func NewHashTableWithHash[K comparable, V any](capacity int32, hash hashtables.HashFunc[K]) *HashTable[K, V] {
+	return NewHashTableWithHasher[K, V](capacity, hash)
+}
+
+func NewHashTableWithHasher[K comparable, V any](capacity int32, hasher hashtables.Hasher[K]) *HashTable[K, V] {
+	var cap int32
+	if capacity == 0 {
+		cap = DEFAULT_CAPACITY
+	} else if helpers.IsPrime(capacity) {
+		cap = capacity
+	} else {
+		cap = helpers.NextPrime(capacity)
+	}
+
+	return &HashTable[K, V]{
+		capacity:   cap,
+		size:       0,
+		loadFactor: 0,
+		Table:      make([]*data.Data[K, V], cap),
+		hasher:     hasher,
+	}
+}
+
+func (h *HashTable[K, V]) Insert(key K, value V) error {
+	if key == *new(K) {
+		return hashtables.ErrInvalidKey
+	}
+	if item := h.entry(key); item != nil {
+		item.Value = value
+		return nil
+	}
+	return h.add(key, value)
+}
+
+// entry returns the stored item for key, or nil if key is absent.
+func (h *HashTable[K, V]) entry(key K) *data.Data[K, V] {
+	index, _, ok := h.lookup(data.NewHashedData(key, *new(V), h.hasher.Hash(key)))
+	if !ok {
+		return nil
+	}
+	return h.Table[index]
+}
+
+// add stores a key that is known to be absent.
+func (h *HashTable[K, V]) add(key K, value V) error {
+	item := data.NewHashedData(key, value, h.hasher.Hash(key))
+	index := h.home(item)
+	var dist uint32 = 0
+	for h.Table[index] != nil {
+		if d := h.distance(h.Table[index], index); d < dist {
+			h.Table[index], item = item, h.Table[index]
+			dist = d
+		}
+		index = h.next(index)
+		dist++
+	}
+	h.Table[index] = item
+
+	h.size++
+	h.loadFactor = float32(h.size) / float32(h.capacity)
+	if h.loadFactor >= MAX_LOAD_FACTOR {
+		return h.resize()
+	}
+	return nil
+}
+
+func (h *HashTable[K, V]) resize() error {
+	ht := NewHashTableWithHasher[K, V](helpers.NextPrime(2*h.capacity), h.hasher)
+	for _, it := range h.Table {
+		if it == nil {
+			continue
+		}
+		err := ht.add(it.GetKey(), it.GetValue())
+		if err != nil {
+			return err
+		}
+	}
+
+	h.Table = ht.Table
+	h.capacity = ht.capacity
+	h.size = ht.size
+	h.loadFactor = ht.loadFactor
+	return nil
+}
+
+func (h *HashTable[K, V]) Find(key K) (V, error) {
+	index, _, err := h.getIndex(key)
+	if err != nil {
+		return *new(V), err
+	}
+	return h.Table[index].GetValue(), nil
+}
+
+func (h *HashTable[K, V]) Delete(key K) error {
+	index, _, err := h.getIndex(key)
+	if err != nil {
+		return err
+	}
+
+	hole := index
+	for i := h.next(index); h.Table[i] != nil && h.distance(h.Table[i], i) > 0; i = h.next(i) {
+		h.Table[hole] = h.Table[i]
+		hole = i
+	}
+	h.Table[hole] = nil
+
+	h.size--
+	h.loadFactor = float32(h.size) / float32(h.capacity)
+	return nil
+}
+
+// ProbeLength returns the number of slots inspected to find key.
+func (h *HashTable[K, V]) ProbeLength(key K) (int32, error) {
+	_, probes, err := h.getIndex(key)
+	return probes, err
+}
+
+func (h *HashTable[K, V]) getIndex(key K) (uint32, int32, error) {
+	if key == *new(K) {
+		return 0, 0, hashtables.ErrInvalidKey
+	}
+	index, probes, ok := h.lookup(data.NewHashedData(key, *new(V), h.hasher.Hash(key)))
+	if !ok {
+		return 0, 0, hashtables.NotFound(key)
+	}
+	return index, probes, nil
+}
+
+// lookup stops as soon as it meets a resident closer to its home than the
+// item would be, since Robin Hood insertion would have displaced it.
+func (h *HashTable[K, V]) lookup(item *data.Data[K, V]) (uint32, int32, bool) {
+	index := h.home(item)
+	for dist := uint32(0); dist < uint32(h.capacity); dist++ {
+		v := h.Table[index]
+		if v == nil || h.distance(v, index) < dist {
+			break
+		}
+		if v.Equal(item) {
+			return index, int32(dist + 1), true
+		}
+		index = h.next(index)
+	}
+	return 0, 0, false
+}
+
+// distance is how far the item at index sits from its home slot.
+func (h *HashTable[K, V]) distance(item *data.Data[K, V], index uint32) uint32 {
+	cap := uint32(h.capacity)
+	return (index + cap - h.home(item)) % cap
+}
+
+func (h *HashTable[K, V]) home(item *data.Data[K, V]) uint32 {
+	return item.GetHash() % uint32(h.capacity)
+}
+
+func (h *HashTable[K, V]) next(index uint32) uint32 {
+	return (index + 1) % uint32(h.capacity)
+}
+
+func (h *HashTable[K, V]) GetCapacity() int32 {
+	return h.capacity
+}
+
+func (h *HashTable[K, V]) GetSize() int32 {
+	return h.size
+}
+
+func (h *HashTable[K, V]) GetLoadFactor() float32 {
+	return h.loadFactor
+}
@@ -0,0 +1,211 @@
+package robinhood
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Insert(t *testing.T) {
+	is := assert.New(t)
+
+	type args struct {
+		key   string
+		value any
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		setup func(*HashTable[string, any])
+		want  func(*HashTable[string, any], error)
+	}{
+		{
+			name: "insert with invalid key",
+			args: args{
+				key:   "",
+				value: "invalid",
+			},
+			want: func(ht *HashTable[string, any], err error) {
+				is.EqualError(err, "invalid key")
+				is.EqualValues(0, ht.GetSize())
+				is.EqualValues(5, ht.GetCapacity())
+			},
+		},
+		{
+			name: "insert a new key-value pair",
+			args: args{
+				key:   "key1",
+				value: "value1",
+			},
+			want: func(ht *HashTable[string, any], err error) {
+				is.Nil(err)
+				is.EqualValues(1, ht.GetSize())
+				is.EqualValues(float32(0.2), ht.GetLoadFactor())
+				value, err := ht.Find("key1")
+				is.Nil(err)
+				is.Equal("value1", value)
+			},
+		},
+		{
+			name: "replace value of previous key",
+			args: args{
+				key:   "key1",
+				value: true,
+			},
+			want: func(ht *HashTable[string, any], err error) {
+				is.Nil(err)
+				is.EqualValues(1, ht.GetSize())
+				value, err := ht.Find("key1")
+				is.Nil(err)
+				is.Equal(true, value)
+			},
+		},
+		{
+			name: "insert colliding keys",
+			args: args{
+				key:   "key4",
+				value: []int{1, 9, 9, 9},
+			},
+			want: func(ht *HashTable[string, any], err error) {
+				is.Nil(err)
+				is.EqualValues(2, ht.GetSize())
+				value, err := ht.Find("key4")
+				is.Nil(err)
+				is.Equal([]int{1, 9, 9, 9}, value)
+			},
+		},
+		{
+			name: "insert to trigger resize",
+			args: args{
+				key:   "resize",
+				value: "value",
+			},
+			setup: func(ht *HashTable[string, any]) {
+				is.Nil(ht.Insert("a", 1))
+				is.Nil(ht.Insert("b", 2))
+			},
+			want: func(ht *HashTable[string, any], err error) {
+				is.Nil(err)
+				for _, key := range []string{"key1", "key4", "resize", "a", "b"} {
+					_, err := ht.Find(key)
+					is.Nil(err)
+				}
+				is.EqualValues(11, ht.GetCapacity())
+				is.EqualValues(5, ht.GetSize())
+			},
+		},
+	}
+
+	hashTable := NewHashTable[string, any](5)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(hashTable)
+			}
+			err := hashTable.Insert(tt.args.key, tt.args.value)
+			tt.want(hashTable, err)
+		})
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name  string
+		key   string
+		setup func(*HashTable[string, any])
+		want  func(*HashTable[string, any], error)
+	}{
+		{
+			name: "delete with invalid key",
+			key:  "",
+			want: func(ht *HashTable[string, any], err error) {
+				is.EqualError(err, "invalid key")
+			},
+		},
+		{
+			name: "delete non-existing key",
+			key:  "nonexistent",
+			want: func(ht *HashTable[string, any], err error) {
+				is.EqualError(err, "key nonexistent not found in hashtable")
+			},
+		},
+		{
+			name: "delete from the middle of a cluster",
+			key:  "key10",
+			setup: func(ht *HashTable[string, any]) {
+				for i := 0; i < 20; i++ {
+					is.Nil(ht.Insert(fmt.Sprintf("key%d", i), i))
+				}
+			},
+			want: func(ht *HashTable[string, any], err error) {
+				is.Nil(err)
+				is.EqualValues(19, ht.GetSize())
+				_, err = ht.Find("key10")
+				is.EqualError(err, "key key10 not found in hashtable")
+				for i := 0; i < 20; i++ {
+					if i == 10 {
+						continue
+					}
+					value, err := ht.Find(fmt.Sprintf("key%d", i))
+					is.Nil(err)
+					is.Equal(i, value)
+				}
+			},
+		},
+		{
+			name: "delete key twice",
+			key:  "key10",
+			want: func(ht *HashTable[string, any], err error) {
+				is.EqualError(err, "key key10 not found in hashtable")
+				is.EqualValues(19, ht.GetSize())
+			},
+		},
+	}
+
+	hashTable := NewHashTable[string, any](5)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(hashTable)
+			}
+			err := hashTable.Delete(tt.key)
+			tt.want(hashTable, err)
+		})
+	}
+}
+
+func Test_RandomOperations(t *testing.T) {
+	is := assert.New(t)
+	ht := NewHashTable[int, int](0)
+	want := make(map[int]int)
+	rng := rand.New(rand.NewSource(31))
+
+	for i := 0; i < 20000; i++ {
+		key := rng.Intn(2000) + 1
+		if rng.Intn(3) == 0 {
+			err := ht.Delete(key)
+			_, ok := want[key]
+			is.Equal(ok, err == nil)
+			delete(want, key)
+			continue
+		}
+		is.Nil(ht.Insert(key, i))
+		want[key] = i
+	}
+
+	is.EqualValues(len(want), ht.GetSize())
+	for key, value := range want {
+		got, err := ht.Find(key)
+		is.Nil(err)
+		is.Equal(value, got)
+
+		probes, err := ht.ProbeLength(key)
+		is.Nil(err)
+		is.GreaterOrEqual(probes, int32(1))
+	}
+}
@@ -0,0 +1,115 @@
+package seperatechaining
+
+import (
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/OladapoAjala/datastructures/sequences/linkedlist"
+	"github.com/OladapoAjala/datastructures/sequences/node"
+	"github.com/OladapoAjala/datastructures/sets/data"
+)
+
+// Iterator visits the table's entries bucket by bucket, following each
+// chain in insertion order. It is fail-fast: once the table gains or loses a
+// key, Next returns false and Err reports
+// hashtables.ErrConcurrentModification. Overwriting the value of an existing
+// key is not a structural change and is seen by the iterator.
+type Iterator[K comparable, V any] struct {
+	table   *HashTable[K, V]
+	version uint64
+	bucket  int
+	node    *node.Node[*data.Data[K, V]]
+	err     error
+}
+
+var _ hashtables.Iterator[string, any] = new(Iterator[string, any])
+
+func (h *HashTable[K, V]) Iterator() *Iterator[K, V] {
+	return &Iterator[K, V]{
+		table:   h,
+		version: h.version,
+		bucket:  -1,
+	}
+}
+
+func (it *Iterator[K, V]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.version != it.table.version {
+		it.node = nil
+		it.err = hashtables.ErrConcurrentModification
+		return false
+	}
+
+	if it.node != nil {
+		it.node = it.node.Next
+	}
+	// During an incremental resize the unmigrated old buckets follow Table.
+	h := it.table
+	for it.node == nil {
+		it.bucket++
+		var ll *linkedlist.LinkedList[*data.Data[K, V]]
+		switch {
+		case it.bucket < len(h.Table):
+			ll = h.Table[it.bucket]
+		case it.bucket < len(h.Table)+len(h.old):
+			ll = h.old[it.bucket-len(h.Table)]
+		default:
+			return false
+		}
+		if ll != nil {
+			it.node = ll.Head
+		}
+	}
+	return true
+}
+
+func (it *Iterator[K, V]) Key() K {
+	if it.node == nil {
+		return *new(K)
+	}
+	return it.node.Data.GetKey()
+}
+
+func (it *Iterator[K, V]) Value() V {
+	if it.node == nil {
+		return *new(V)
+	}
+	return it.node.Data.GetValue()
+}
+
+func (it *Iterator[K, V]) Err() error {
+	return it.err
+}
+
+// Range calls f for each entry until f returns false. f may overwrite
+// values, but Range panics with hashtables.ErrConcurrentModification if f
+// inserts a new key or deletes one.
+func (h *HashTable[K, V]) Range(f func(K, V) bool) {
+	it := h.Iterator()
+	for it.Next() {
+		if !f(it.Key(), it.Value()) {
+			return
+		}
+	}
+	if err := it.Err(); err != nil {
+		panic(err)
+	}
+}
+
+func (h *HashTable[K, V]) Keys() []K {
+	keys := make([]K, 0, h.size)
+	h.Range(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+func (h *HashTable[K, V]) Values() []V {
+	values := make([]V, 0, h.size)
+	h.Range(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
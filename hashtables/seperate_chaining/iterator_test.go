@@ -0,0 +1,141 @@
+package seperatechaining
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Iterator(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name    string
+		deleted []int
+		want    []int
+	}{
+		{
+			name: "empty table",
+			want: []int{},
+		},
+		{
+			name: "every entry once",
+			want: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		},
+		{
+			name:    "deleted entries are skipped",
+			deleted: []int{2, 5, 9},
+			want:    []int{1, 3, 4, 6, 7, 8, 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ht := NewHashTable[int, string](5)
+			if len(tt.want) > 0 {
+				for i := 1; i <= 10; i++ {
+					is.Nil(ht.Insert(i, fmt.Sprint(i)))
+				}
+			}
+			for _, key := range tt.deleted {
+				is.Nil(ht.Delete(key))
+			}
+
+			got := []int{}
+			it := ht.Iterator()
+			for it.Next() {
+				is.Equal(fmt.Sprint(it.Key()), it.Value())
+				got = append(got, it.Key())
+			}
+			is.Nil(it.Err())
+			is.False(it.Next())
+			is.Zero(it.Key())
+
+			sort.Ints(got)
+			is.Equal(tt.want, got)
+
+			keys := ht.Keys()
+			sort.Ints(keys)
+			is.Equal(tt.want, keys)
+			is.Len(ht.Values(), len(tt.want))
+		})
+	}
+}
+
+func Test_Range(t *testing.T) {
+	is := assert.New(t)
+
+	ht := NewHashTable[string, int](7)
+	for i := 1; i <= 20; i++ {
+		is.Nil(ht.Insert(fmt.Sprintf("key%d", i), i))
+	}
+
+	sum := 0
+	ht.Range(func(_ string, v int) bool {
+		sum += v
+		return true
+	})
+	is.Equal(210, sum)
+
+	visits := 0
+	ht.Range(func(string, int) bool {
+		visits++
+		return visits < 3
+	})
+	is.Equal(3, visits)
+
+	ht.Range(func(k string, v int) bool {
+		is.Nil(ht.Insert(k, v*2))
+		return true
+	})
+	value, err := ht.Find("key7")
+	is.Nil(err)
+	is.Equal(14, value)
+}
+
+func Test_ConcurrentModification(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name   string
+		mutate func(*HashTable[int, int], int) error
+	}{
+		{
+			name: "insert a new key",
+			mutate: func(ht *HashTable[int, int], k int) error {
+				return ht.Insert(k+100, k)
+			},
+		},
+		{
+			name: "delete a key",
+			mutate: func(ht *HashTable[int, int], k int) error {
+				return ht.Delete(k)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ht := NewHashTable[int, int](7)
+			for i := 1; i <= 5; i++ {
+				is.Nil(ht.Insert(i, i))
+			}
+
+			it := ht.Iterator()
+			is.True(it.Next())
+			is.Nil(tt.mutate(ht, it.Key()))
+			is.False(it.Next())
+			is.ErrorIs(it.Err(), hashtables.ErrConcurrentModification)
+
+			is.PanicsWithError(hashtables.ErrConcurrentModification.Error(), func() {
+				ht.Range(func(k, _ int) bool {
+					is.Nil(tt.mutate(ht, k))
+					return true
+				})
+			})
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package seperatechaining
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewHashTableWithConfig(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name          string
+		capacity      int32
+		config        hashtables.Config[int]
+		wantCapacity  int32
+		wantThreshold int32
+		wantErr       bool
+	}{
+		{
+			name:          "zero config takes the defaults",
+			capacity:      10,
+			config:        hashtables.Config[int]{},
+			wantCapacity:  10,
+			wantThreshold: 8,
+		},
+		{
+			name:          "load factor above one",
+			capacity:      10,
+			config:        hashtables.Config[int]{MaxLoadFactor: 2, MinLoadFactor: 0.5},
+			wantCapacity:  10,
+			wantThreshold: 20,
+		},
+		{
+			name:          "zero capacity takes the default",
+			config:        hashtables.Config[int]{},
+			wantCapacity:  DEFAULT_CAPACITY,
+			wantThreshold: 12,
+		},
+		{
+			name:     "negative capacity",
+			capacity: -1,
+			config:   hashtables.Config[int]{},
+			wantErr:  true,
+		},
+		{
+			name:     "negative max load factor",
+			capacity: 10,
+			config:   hashtables.Config[int]{MaxLoadFactor: -1},
+			wantErr:  true,
+		},
+		{
+			name:     "min load factor too close to max",
+			capacity: 10,
+			config:   hashtables.Config[int]{MaxLoadFactor: 0.8, MinLoadFactor: 0.4},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ht, err := NewHashTableWithConfig[int, int](tt.capacity, tt.config)
+			if tt.wantErr {
+				is.ErrorIs(err, hashtables.ErrInvalidConfig)
+				is.Nil(ht)
+				return
+			}
+			is.Nil(err)
+			is.Equal(tt.wantCapacity, ht.GetCapacity())
+			is.Equal(tt.wantThreshold, ht.GetThreshold())
+		})
+	}
+
+	ht := NewHashTable[int, int](0)
+	for key := 1; key <= 100; key++ {
+		is.Nil(ht.Insert(key, key))
+	}
+	is.EqualValues(100, ht.GetSize())
+}
+
+func Test_Shrink(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name        string
+		incremental bool
+		want        func(ht *HashTable[int, int], grown int32)
+	}{
+		{
+			name: "shrinks until the load is back above the minimum",
+			want: func(ht *HashTable[int, int], grown int32) {
+				is.EqualValues(16, ht.GetCapacity())
+				is.False(ht.IsRehashing())
+			},
+		},
+		{
+			name:        "incremental shrink migrates as keys are deleted",
+			incremental: true,
+			want: func(ht *HashTable[int, int], grown int32) {
+				is.Less(ht.GetCapacity(), grown)
+				is.GreaterOrEqual(ht.GetCapacity(), int32(8))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ht, err := NewHashTableWithConfig[int, int](8, hashtables.Config[int]{
+				MinLoadFactor: 0.2,
+				Incremental:   tt.incremental,
+			})
+			is.Nil(err)
+
+			for key := 1; key <= 1000; key++ {
+				is.Nil(ht.Insert(key, key))
+			}
+			grown := ht.GetCapacity()
+
+			for key := 1; key <= 995; key++ {
+				is.Nil(ht.Delete(key))
+			}
+			for key := 996; key <= 1000; key++ {
+				value, err := ht.Find(key)
+				is.Nil(err)
+				is.Equal(key, value)
+			}
+			is.EqualValues(5, ht.GetSize())
+			tt.want(ht, grown)
+		})
+	}
+
+	ht := NewHashTable[int, int](8)
+	for key := 1; key <= 100; key++ {
+		is.Nil(ht.Insert(key, key))
+	}
+	grown := ht.GetCapacity()
+	for key := 1; key <= 100; key++ {
+		is.Nil(ht.Delete(key))
+	}
+	is.Equal(grown, ht.GetCapacity())
+}
+
+func Test_IncrementalResize(t *testing.T) {
+	is := assert.New(t)
+
+	ht, err := NewHashTableWithConfig[int, int](8, hashtables.Config[int]{
+		MinLoadFactor: 0.1,
+		Incremental:   true,
+	})
+	is.Nil(err)
+
+	r := rand.New(rand.NewSource(35))
+	want := map[int]int{}
+	rehashed := 0
+
+	for i := 0; i < 20000; i++ {
+		key := r.Intn(3000) + 1
+		switch op := r.Intn(10); {
+		case op < 6 || i < 5000:
+			is.Nil(ht.Insert(key, i))
+			want[key] = i
+		case op < 9 || i > 15000:
+			err := ht.Delete(key)
+			if _, ok := want[key]; ok {
+				is.Nil(err)
+				delete(want, key)
+			} else {
+				is.ErrorIs(err, hashtables.ErrNotFound)
+			}
+		default:
+			value, err := ht.Find(key)
+			if v, ok := want[key]; ok {
+				is.Nil(err)
+				is.Equal(v, value)
+			} else {
+				is.ErrorIs(err, hashtables.ErrNotFound)
+			}
+		}
+
+		if ht.IsRehashing() {
+			rehashed++
+			for _, ll := range ht.old[:ht.rehash] {
+				is.Nil(ll)
+			}
+		}
+		is.EqualValues(len(want), ht.GetSize())
+	}
+	is.Greater(rehashed, 0)
+
+	keys := ht.Keys()
+	is.Len(keys, len(want))
+	for _, key := range keys {
+		value, err := ht.Find(key)
+		is.Nil(err)
+		is.Equal(want[key], value)
+	}
+}
+
+func Test_IncrementalIteration(t *testing.T) {
+	is := assert.New(t)
+
+	ht, err := NewHashTableWithConfig[int, int](8, hashtables.Config[int]{Incremental: true})
+	is.Nil(err)
+
+	want := []int{}
+	for key := 1; !ht.IsRehashing() || len(want) < 10; key++ {
+		is.Nil(ht.Insert(key, key))
+		want = append(want, key)
+	}
+	is.True(ht.IsRehashing())
+
+	keys := ht.Keys()
+	sort.Ints(keys)
+	is.Equal(want, keys)
+
+	probes, err := ht.ProbeLength(1)
+	is.Nil(err)
+	is.Greater(probes, int32(0))
+}
@@ -2,162 +2,309 @@ package seperatechaining
 
 import (
 	"fmt"
-	"hash/fnv"
 
 	"github.com/OladapoAjala/datastructures/hashtables"
 	"github.com/OladapoAjala/datastructures/sequences/linkedlist"
 	"github.com/OladapoAjala/datastructures/sets/data"
-	"golang.org/x/exp/constraints"
 )
 
-type HashTable[K constraints.Ordered] struct {
-	capacity  int32
-	size      int32
-	threshold int32
-	Table     []*linkedlist.LinkedList[*data.Data[K, any]]
+type HashTable[K comparable, V any] struct {
+	capacity    int32
+	size        int32
+	threshold   int32
+	hasher      hashtables.Hasher[K]
+	version     uint64
+	maxLoad     float32
+	minLoad     float32
+	minCapacity int32
+	incremental bool
+	Table       []*linkedlist.LinkedList[*data.Data[K, V]]
+	// old holds the previous buckets while an incremental resize migrates
+	// them. Buckets before rehash have been moved to Table.
+	old    []*linkedlist.LinkedList[*data.Data[K, V]]
+	rehash int
 }
 
-type HashTabler[K constraints.Ordered] interface {
-	hashtables.HashTabler[K]
+type HashTabler[K comparable, V any] interface {
+	hashtables.HashTabler[K, V]
+	hashtables.Prober[K]
+	hashtables.Ranger[K, V]
+	Iterator() *Iterator[K, V]
+	Stats() hashtables.Stats
 	GetThreshold() int32
+	IsRehashing() bool
 }
 
-const MAX_LOAD_FACTOR float32 = 0.80
+const (
+	DEFAULT_CAPACITY         = 16
+	MAX_LOAD_FACTOR  float32 = 0.80
+	// REHASH_STEP is the number of old buckets an incremental resize
+	// migrates on each insert or delete.
+	REHASH_STEP = 4
+)
 
-var _ HashTabler[string] = new(HashTable[string])
+var _ HashTabler[string, any] = new(HashTable[string, any])
 
-func NewHashTable[K constraints.Ordered](capacity int32) *HashTable[K] {
-	table := make([]*linkedlist.LinkedList[*data.Data[K, any]], capacity)
-	threshold := float32(capacity) * MAX_LOAD_FACTOR
+func NewHashTable[K comparable, V any](capacity int32) *HashTable[K, V] {
+	return NewHashTableWithHasher[K, V](capacity, hashtables.FNVHasher[K]{})
+}
 
-	return &HashTable[K]{
-		capacity:  capacity,
-		size:      0,
-		threshold: int32(threshold),
-		Table:     table,
+func NewHashTableWithHash[K comparable, V any](capacity int32, hash hashtables.HashFunc[K]) *HashTable[K, V] {
+	return NewHashTableWithHasher[K, V](capacity, hash)
+}
+
+func NewHashTableWithHasher[K comparable, V any](capacity int32, hasher hashtables.Hasher[K]) *HashTable[K, V] {
+	ht, _ := NewHashTableWithConfig[K, V](capacity, hashtables.Config[K]{Hasher: hasher})
+	return ht
+}
+
+// NewHashTableWithConfig returns hashtables.ErrInvalidConfig unless
+// MaxLoadFactor is positive and MinLoadFactor is below half of it, or if
+// capacity is negative. A zero capacity takes DEFAULT_CAPACITY. The table
+// never shrinks below capacity.
+func NewHashTableWithConfig[K comparable, V any](capacity int32, config hashtables.Config[K]) (*HashTable[K, V], error) {
+	if capacity < 0 {
+		return nil, hashtables.ErrInvalidConfig
+	}
+	if capacity == 0 {
+		capacity = DEFAULT_CAPACITY
+	}
+	if config.Hasher == nil {
+		config.Hasher = hashtables.FNVHasher[K]{}
+	}
+	if config.MaxLoadFactor == 0 {
+		config.MaxLoadFactor = MAX_LOAD_FACTOR
 	}
+	if config.MaxLoadFactor < 0 ||
+		config.MinLoadFactor < 0 || config.MinLoadFactor >= config.MaxLoadFactor/2 {
+		return nil, hashtables.ErrInvalidConfig
+	}
+
+	table := make([]*linkedlist.LinkedList[*data.Data[K, V]], capacity)
+	threshold := float32(capacity) * config.MaxLoadFactor
+
+	return &HashTable[K, V]{
+		capacity:    capacity,
+		size:        0,
+		threshold:   int32(threshold),
+		hasher:      config.Hasher,
+		maxLoad:     config.MaxLoadFactor,
+		minLoad:     config.MinLoadFactor,
+		minCapacity: capacity,
+		incremental: config.Incremental,
+		Table:       table,
+	}, nil
 }
 
-func (h *HashTable[K]) Insert(key K, value any) error {
+func (h *HashTable[K, V]) Insert(key K, value V) error {
 	if key == *new(K) {
-		return fmt.Errorf("invalid key")
+		return hashtables.ErrInvalidKey
 	}
-	item := data.NewDataWithHash(key, value)
-	pos := item.GetHash() % uint32(h.capacity)
-
-	if isPresent, prev := h.contains(item, pos); isPresent {
-		prev.Value = value
+	if item := h.entry(key); item != nil {
+		item.Value = value
 		return nil
 	}
+	return h.add(key, value)
+}
 
-	if h.Table[pos] == nil {
-		h.Table[pos] = linkedlist.NewList[*data.Data[K, any]]()
+// entry returns the stored item for key, or nil if key is absent. Migrated
+// buckets of old are empty, so both tables can be searched directly.
+func (h *HashTable[K, V]) entry(key K) *data.Data[K, V] {
+	item := data.NewHashedData(key, *new(V), h.hasher.Hash(key))
+	for _, table := range h.tables() {
+		if found := h.search(table[item.GetHash()%uint32(len(table))], item); found != nil {
+			return found
+		}
 	}
+	return nil
+}
 
-	err := h.Table[pos].InsertLast(item)
+// add stores a key that is known to be absent.
+func (h *HashTable[K, V]) add(key K, value V) error {
+	if err := h.migrate(REHASH_STEP); err != nil {
+		return err
+	}
+	err := h.place(h.Table, data.NewHashedData(key, value, h.hasher.Hash(key)))
 	if err != nil {
 		return err
 	}
+	h.version++
 	h.size++
 
 	if h.size > h.threshold {
-		return h.resize()
+		return h.resize(h.capacity * 2)
 	}
 	return nil
 }
 
-func (h *HashTable[K]) resize() error {
-	capacity := h.capacity * 2
-	ht := NewHashTable[K](capacity)
+func (h *HashTable[K, V]) place(table []*linkedlist.LinkedList[*data.Data[K, V]], item *data.Data[K, V]) error {
+	pos := item.GetHash() % uint32(len(table))
+	if table[pos] == nil {
+		table[pos] = linkedlist.NewList[*data.Data[K, V]]()
+	}
+	return table[pos].InsertLast(item)
+}
+
+// resize moves the entries into a table of the given capacity, either at
+// once or, for incremental tables, over the following operations.
+func (h *HashTable[K, V]) resize(capacity int32) error {
+	if err := h.migrate(len(h.old)); err != nil {
+		return err
+	}
 
-	for _, ll := range h.Table {
+	old := h.Table
+	h.Table = make([]*linkedlist.LinkedList[*data.Data[K, V]], capacity)
+	h.capacity = capacity
+	h.threshold = int32(float32(capacity) * h.maxLoad)
+
+	if h.incremental {
+		h.old = old
+		h.rehash = 0
+		return nil
+	}
+	for _, ll := range old {
 		if ll == nil {
 			continue
 		}
-
-		for i := int32(0); i < ll.GetSize(); i++ {
-			node, err := ll.GetNode(i)
-			if err != nil {
+		for it := ll.Head; it != nil; it = it.Next {
+			if err := h.place(h.Table, it.Data); err != nil {
 				return fmt.Errorf("error resizing table %w", err)
 			}
+		}
+	}
+	return nil
+}
 
-			err = ht.Insert(node.Data.GetKey(), node.Data.GetValue())
-			if err != nil {
-				return fmt.Errorf("error resizing table %w", err)
+// migrate moves up to n buckets of an in-progress incremental resize into
+// Table.
+func (h *HashTable[K, V]) migrate(n int) error {
+	for ; n > 0 && h.rehash < len(h.old); n-- {
+		if ll := h.old[h.rehash]; ll != nil {
+			for it := ll.Head; it != nil; it = it.Next {
+				if err := h.place(h.Table, it.Data); err != nil {
+					return fmt.Errorf("error resizing table %w", err)
+				}
 			}
+			h.old[h.rehash] = nil
 		}
+		h.rehash++
+	}
+	if h.old != nil && h.rehash == len(h.old) {
+		h.old = nil
+		h.rehash = 0
 	}
-
-	h.capacity = ht.capacity
-	h.size = ht.size
-	h.threshold = ht.threshold
-	h.Table = ht.Table
 	return nil
 }
 
-func (h *HashTable[K]) contains(input *data.Data[K, any], pos uint32) (bool, *data.Data[K, any]) {
-	ll := h.Table[pos]
-	if ll == nil {
-		return false, nil
+// IsRehashing reports whether an incremental resize is in progress.
+func (h *HashTable[K, V]) IsRehashing() bool {
+	return h.old != nil
+}
+
+func (h *HashTable[K, V]) tables() [][]*linkedlist.LinkedList[*data.Data[K, V]] {
+	if h.old == nil {
+		return [][]*linkedlist.LinkedList[*data.Data[K, V]]{h.Table}
 	}
+	return [][]*linkedlist.LinkedList[*data.Data[K, V]]{h.Table, h.old}
+}
 
+func (h *HashTable[K, V]) contains(input *data.Data[K, V], pos uint32) (bool, *data.Data[K, V]) {
+	found := h.search(h.Table[pos], input)
+	return found != nil, found
+}
+
+func (h *HashTable[K, V]) search(ll *linkedlist.LinkedList[*data.Data[K, V]], input *data.Data[K, V]) *data.Data[K, V] {
+	if ll == nil {
+		return nil
+	}
 	for it := ll.Head; it != nil; it = it.Next {
 		if input.Equal(it.Data) {
-			return true, it.Data
+			return it.Data
 		}
 	}
-	return false, nil
+	return nil
 }
 
-func (h *HashTable[K]) Find(key K) (any, error) {
+func (h *HashTable[K, V]) Find(key K) (V, error) {
 	if key == *new(K) {
-		return nil, fmt.Errorf("invalid key")
+		return *new(V), hashtables.ErrInvalidKey
 	}
-	hasher := fnv.New32()
-	hasher.Write([]byte(data.ToString(key)))
-	pos := hasher.Sum32() % uint32(h.capacity)
-
-	if h.Table[pos] == nil {
-		return nil, fmt.Errorf("key %v not found in hashtable", key)
+	if item := h.entry(key); item != nil {
+		return item.GetValue(), nil
 	}
-
-	return h.Table[pos].Head.Data.GetValue(), nil
+	return *new(V), hashtables.NotFound(key)
 }
 
-func (h *HashTable[K]) Delete(key K) error {
-	hasher := fnv.New32()
-	hasher.Write([]byte(data.ToString(key)))
-	pos := hasher.Sum32() % uint32(h.capacity)
-
-	if h.Table[pos] == nil {
-		return fmt.Errorf("key %v not found in hashtable", key)
+func (h *HashTable[K, V]) Delete(key K) error {
+	if key == *new(K) {
+		return hashtables.ErrInvalidKey
+	}
+	removed := false
+	for _, table := range h.tables() {
+		if removed = h.remove(table, key); removed {
+			break
+		}
 	}
+	if !removed {
+		return hashtables.NotFound(key)
+	}
+	h.version++
+	h.size--
 
-	ll := h.Table[pos]
-	index, err := h.getIndex(key, ll)
-	if err != nil {
+	if err := h.migrate(REHASH_STEP); err != nil {
 		return err
 	}
+	if h.old == nil && float32(h.size) < float32(h.capacity)*h.minLoad && h.capacity/2 >= h.minCapacity {
+		return h.resize(h.capacity / 2)
+	}
+	return nil
+}
 
-	err = h.Table[pos].Delete(index)
+func (h *HashTable[K, V]) remove(table []*linkedlist.LinkedList[*data.Data[K, V]], key K) bool {
+	pos := h.hasher.Hash(key) % uint32(len(table))
+	ll := table[pos]
+	if ll == nil {
+		return false
+	}
+	index, err := h.getIndex(key, ll)
 	if err != nil {
-		return fmt.Errorf("key %v not found in hashtable", key)
+		return false
+	}
+	if err := ll.Delete(index); err != nil {
+		return false
 	}
+	if ll.GetSize() == 0 {
+		table[pos] = nil
+	}
+	return true
+}
 
-	h.size--
-	if h.Table[pos].GetSize() == 0 {
-		h.Table[pos] = nil
+// ProbeLength returns the number of chain entries inspected to find key.
+func (h *HashTable[K, V]) ProbeLength(key K) (int32, error) {
+	if key == *new(K) {
+		return 0, hashtables.ErrInvalidKey
 	}
 
-	return nil
+	var probes int32
+	for _, table := range h.tables() {
+		ll := table[h.hasher.Hash(key)%uint32(len(table))]
+		if ll == nil {
+			continue
+		}
+		if index, err := h.getIndex(key, ll); err == nil {
+			return probes + index + 1, nil
+		}
+		probes += ll.GetSize()
+	}
+	return 0, hashtables.NotFound(key)
 }
 
-func (h *HashTable[K]) getIndex(key K, ll *linkedlist.LinkedList[*data.Data[K, any]]) (int32, error) {
+func (h *HashTable[K, V]) getIndex(key K, ll *linkedlist.LinkedList[*data.Data[K, V]]) (int32, error) {
 	if ll.IsEmpty() {
 		return -1, fmt.Errorf("empty list")
 	}
 
-	data := data.NewDataWithHash[K, any](key, nil)
+	data := data.NewHashedData(key, *new(V), h.hasher.Hash(key))
 	var index int32 = 0
 	for it := ll.Head; it != nil; it = it.Next {
 		if data.Equal(it.Data) {
@@ -169,14 +316,14 @@ func (h *HashTable[K]) getIndex(key K, ll *linkedlist.LinkedList[*data.Data[K, a
 	return -1, fmt.Errorf("key not found")
 }
 
-func (h *HashTable[K]) GetSize() int32 {
+func (h *HashTable[K, V]) GetSize() int32 {
 	return h.size
 }
 
-func (h *HashTable[K]) GetCapacity() int32 {
+func (h *HashTable[K, V]) GetCapacity() int32 {
 	return h.capacity
 }
 
-func (h *HashTable[K]) GetThreshold() int32 {
+func (h *HashTable[K, V]) GetThreshold() int32 {
 	return h.threshold
 }
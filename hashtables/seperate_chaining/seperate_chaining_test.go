@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/OladapoAjala/datastructures/hashtables"
 	"github.com/OladapoAjala/datastructures/sets/data"
 	"github.com/stretchr/testify/assert"
 )
@@ -19,7 +20,7 @@ func Test_Insert(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want func(*HashTable[string], error)
+		want func(*HashTable[string, any], error)
 	}{
 		{
 			name: "insert a new key-value pair",
@@ -27,7 +28,7 @@ func Test_Insert(t *testing.T) {
 				key:   "key1",
 				value: "value1",
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
 				is.Equal(ht.Table[1].Head.Data.Key, "key1")
 				is.Equal(ht.Table[1].Head.Data.Value, "value1")
@@ -40,7 +41,7 @@ func Test_Insert(t *testing.T) {
 				key:   "key1",
 				value: true,
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
 				is.Equal(ht.Table[1].Head.Data.Key, "key1")
 				is.Equal(ht.Table[1].Head.Data.Value, true)
@@ -53,7 +54,7 @@ func Test_Insert(t *testing.T) {
 				key:   "key0",
 				value: "value2",
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
 				is.Equal(ht.Table[0].Head.Data.Key, "key0")
 				is.Equal(ht.Table[0].Head.Data.Value, "value2")
@@ -66,7 +67,7 @@ func Test_Insert(t *testing.T) {
 				key:   "key3",
 				value: 100,
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
 				is.Equal(ht.Table[5].Head.Data.Key, "key3")
 				is.Equal(ht.Table[5].Head.Data.Value, 100)
@@ -83,7 +84,7 @@ func Test_Insert(t *testing.T) {
 				key:   "key3",
 				value: 100,
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Error(fmt.Errorf("key: key3, value: 100 already in hash table"))
 				is.Equal(ht.Table[5].Head.Data.Key, "key3")
 				is.Equal(ht.Table[5].Head.Data.Value, 100)
@@ -92,7 +93,7 @@ func Test_Insert(t *testing.T) {
 		},
 	}
 
-	hashTable := NewHashTable[string](3)
+	hashTable := NewHashTable[string, any](3)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			err := hashTable.Insert(tt.args.key, tt.args.value)
@@ -104,7 +105,7 @@ func Test_Insert(t *testing.T) {
 func TestHashTable_Find(t *testing.T) {
 	is := assert.New(t)
 
-	hashTable := NewHashTable[string](10)
+	hashTable := NewHashTable[string, any](10)
 	hashTable.Insert("key1", false)
 	hashTable.Insert("key2", "value2")
 	hashTable.Insert("key3", "value3")
@@ -164,29 +165,29 @@ func Test_Delete(t *testing.T) {
 	}
 	tests := []struct {
 		name  string
-		setup func(ht *HashTable[string])
+		setup func(ht *HashTable[string, any])
 		args  args
-		want  func(*HashTable[string], error)
+		want  func(*HashTable[string, any], error)
 	}{
 		{
 			name: "delete from empty hashtable",
 			args: args{
 				key: "key1",
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Error(fmt.Errorf("key nonexistent not found in hashtable"))
 			},
 		},
 		{
 			name: "delete existing key",
-			setup: func(ht *HashTable[string]) {
+			setup: func(ht *HashTable[string, any]) {
 				err := ht.Insert("key1", "value1")
 				is.Nil(err)
 			},
 			args: args{
 				key: "key1",
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
 				tmp := data.NewDataWithHash[string, any]("key1", "value1")
 				pos := tmp.GetHash() % uint32(3)
@@ -197,19 +198,19 @@ func Test_Delete(t *testing.T) {
 		},
 		{
 			name: "delete non-existent key",
-			setup: func(ht *HashTable[string]) {
+			setup: func(ht *HashTable[string, any]) {
 				_ = ht.Insert("key2", "value2")
 			},
 			args: args{
 				key: "nonexistent",
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Error(fmt.Errorf("key nonexistent not found in hashtable"))
 			},
 		},
 		{
 			name: "delete key with collision",
-			setup: func(ht *HashTable[string]) {
+			setup: func(ht *HashTable[string, any]) {
 				err := ht.Insert("key1", 100)
 				is.Nil(err)
 				err = ht.Insert("key2", true)
@@ -218,7 +219,7 @@ func Test_Delete(t *testing.T) {
 			args: args{
 				key: "key2",
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
 
 				tmp := data.NewDataWithHash[string, any]("key2", true)
@@ -240,7 +241,7 @@ func Test_Delete(t *testing.T) {
 			args: args{
 				key: "key1",
 			},
-			want: func(ht *HashTable[string], err error) {
+			want: func(ht *HashTable[string, any], err error) {
 				is.Nil(err)
 
 				tmp := data.NewDataWithHash[string, any]("key1", 100)
@@ -252,7 +253,7 @@ func Test_Delete(t *testing.T) {
 		},
 	}
 
-	hashTable := NewHashTable[string](3)
+	hashTable := NewHashTable[string, any](3)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.setup != nil {
@@ -264,3 +265,61 @@ func Test_Delete(t *testing.T) {
 		})
 	}
 }
+
+func Test_ComparableKeys(t *testing.T) {
+	is := assert.New(t)
+
+	type point struct {
+		x, y int
+	}
+
+	tests := []struct {
+		name string
+		ht   *HashTable[point, string]
+	}{
+		{
+			name: "struct keys with the default hash",
+			ht:   NewHashTable[point, string](5),
+		},
+		{
+			name: "struct keys with a custom hash",
+			ht: NewHashTableWithHash[point, string](5, func(p point) uint32 {
+				return uint32(p.x*31 + p.y)
+			}),
+		},
+		{
+			name: "struct keys with a colliding hash",
+			ht: NewHashTableWithHash[point, string](5, func(p point) uint32 {
+				return 7
+			}),
+		},
+		{
+			name: "struct keys with a seeded hasher",
+			ht:   NewHashTableWithHasher[point, string](5, hashtables.NewSipHasher[point](1, 2)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 1; i <= 20; i++ {
+				is.Nil(tt.ht.Insert(point{i, -i}, fmt.Sprintf("p%d", i)))
+			}
+			is.EqualValues(20, tt.ht.GetSize())
+
+			value, err := tt.ht.Find(point{7, -7})
+			is.Nil(err)
+			is.Equal("p7", value)
+
+			is.Nil(tt.ht.Delete(point{7, -7}))
+			value, err = tt.ht.Find(point{7, -7})
+			is.Error(err)
+			is.Empty(value)
+
+			_, err = tt.ht.Find(point{7, 7})
+			is.Error(err)
+
+			err = tt.ht.Insert(point{}, "zero")
+			is.EqualError(err, "invalid key")
+		})
+	}
+}
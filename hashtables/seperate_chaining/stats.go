@@ -0,0 +1,43 @@
+package seperatechaining
+
+import "github.com/OladapoAjala/datastructures/hashtables"
+
+// Stats reports how the table's buckets are used. Every bucket is a chain,
+// so ChainLengths[0] counts the empty buckets, and chaining leaves no
+// tombstones. EffectiveLoadFactor is the mean length of the non-empty
+// chains. During an incremental resize the chains not yet migrated are
+// included, but empty old buckets are not.
+func (h *HashTable[K, V]) Stats() hashtables.Stats {
+	stats := hashtables.Stats{
+		Size:       h.size,
+		Capacity:   h.capacity,
+		LoadFactor: float32(h.size) / float32(h.capacity),
+	}
+
+	var chains int32
+	for i, table := range h.tables() {
+		for _, ll := range table {
+			var length int32
+			if ll != nil {
+				length = ll.GetSize()
+			}
+			if length == 0 && i > 0 {
+				continue
+			}
+			if length > 0 {
+				chains++
+			}
+			stats.AddChain(length)
+		}
+	}
+	if chains > 0 {
+		stats.EffectiveLoadFactor = float32(h.size) / float32(chains)
+	}
+
+	h.Range(func(key K, _ V) bool {
+		probes, _ := h.ProbeLength(key)
+		stats.AddProbe(probes)
+		return true
+	})
+	return stats
+}
@@ -0,0 +1,90 @@
+package seperatechaining
+
+import (
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Stats(t *testing.T) {
+	is := assert.New(t)
+	ht := NewHashTableWithHash[int, int](10, func(k int) uint32 { return uint32(k % 3) })
+
+	tests := []struct {
+		name   string
+		action func()
+		want   func(hashtables.Stats)
+	}{
+		{
+			name: "empty table",
+			want: func(s hashtables.Stats) {
+				is.EqualValues(10, s.Capacity)
+				is.Equal([]int32{10}, s.ChainLengths)
+				is.Zero(s.LongestChain)
+				is.Zero(s.EffectiveLoadFactor)
+			},
+		},
+		{
+			name: "keys in three chains",
+			action: func() {
+				for key := 1; key <= 7; key++ {
+					is.Nil(ht.Insert(key, key))
+				}
+			},
+			want: func(s hashtables.Stats) {
+				is.EqualValues(7, s.Size)
+				is.Zero(s.Tombstones)
+				is.Equal([]int32{7, 0, 2, 1}, s.ChainLengths)
+				is.EqualValues(3, s.LongestChain)
+				is.Equal([]int32{0, 3, 3, 1}, s.ProbeLengths)
+				is.InDelta(0.7, s.LoadFactor, 1e-6)
+				is.InDelta(7.0/3, s.EffectiveLoadFactor, 1e-6)
+			},
+		},
+		{
+			name: "deleting empties a chain",
+			action: func() {
+				is.Nil(ht.Delete(3))
+				is.Nil(ht.Delete(6))
+			},
+			want: func(s hashtables.Stats) {
+				is.EqualValues(5, s.Size)
+				is.Equal([]int32{8, 0, 1, 1}, s.ChainLengths)
+				is.Equal([]int32{0, 2, 2, 1}, s.ProbeLengths)
+				is.InDelta(2.5, s.EffectiveLoadFactor, 1e-6)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.action != nil {
+				tt.action()
+			}
+			tt.want(ht.Stats())
+		})
+	}
+}
+
+func Test_StatsDuringIncrementalResize(t *testing.T) {
+	is := assert.New(t)
+	ht, err := NewHashTableWithConfig[int, int](8, hashtables.Config[int]{Incremental: true})
+	is.Nil(err)
+
+	for key := 1; key <= 7; key++ {
+		is.Nil(ht.Insert(key, key))
+	}
+	is.True(ht.IsRehashing())
+
+	s := ht.Stats()
+	var entries, keys int32
+	for n, count := range s.ChainLengths {
+		entries += int32(n) * count
+	}
+	for _, count := range s.ProbeLengths {
+		keys += count
+	}
+	is.EqualValues(7, entries)
+	is.EqualValues(7, keys)
+}
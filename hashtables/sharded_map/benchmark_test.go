@@ -0,0 +1,128 @@
+package shardedmap_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	seperatechaining "github.com/OladapoAjala/datastructures/hashtables/seperate_chaining"
+	shardedmap "github.com/OladapoAjala/datastructures/hashtables/sharded_map"
+)
+
+// store is the subset of operations the benchmarks drive.
+type store interface {
+	Insert(int, int) error
+	Find(int) (int, error)
+}
+
+type syncMap struct{ m sync.Map }
+
+func (s *syncMap) Insert(k, v int) error {
+	s.m.Store(k, v)
+	return nil
+}
+
+func (s *syncMap) Find(k int) (int, error) {
+	v, _ := s.m.Load(k)
+	n, _ := v.(int)
+	return n, nil
+}
+
+type mutexMap struct {
+	mu sync.RWMutex
+	m  map[int]int
+}
+
+func (s *mutexMap) Insert(k, v int) error {
+	s.mu.Lock()
+	s.m[k] = v
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *mutexMap) Find(k int) (int, error) {
+	s.mu.RLock()
+	v := s.m[k]
+	s.mu.RUnlock()
+	return v, nil
+}
+
+// mutexTable is the global lock around a single table that the sharded map
+// replaces.
+type mutexTable struct {
+	mu sync.RWMutex
+	ht *seperatechaining.HashTable[int, int]
+}
+
+func (s *mutexTable) Insert(k, v int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ht.Insert(k, v)
+}
+
+func (s *mutexTable) Find(k int) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.ht.Find(k)
+}
+
+var stores = []struct {
+	name string
+	new  func() store
+}{
+	{"sharded_map", func() store { return shardedmap.NewShardedMap[int, int](0) }},
+	{"sync.Map", func() store { return new(syncMap) }},
+	{"mutex_map", func() store { return &mutexMap{m: map[int]int{}} }},
+	{"mutex_table", func() store { return &mutexTable{ht: seperatechaining.NewHashTable[int, int](16)} }},
+}
+
+const keys = 1 << 14
+
+// benchmarkMix runs parallel goroutines where writes out of every 10
+// operations are inserts and the rest are finds.
+func benchmarkMix(b *testing.B, writes int) {
+	for _, st := range stores {
+		b.Run(st.name, func(b *testing.B) {
+			s := st.new()
+			for key := 1; key <= keys; key++ {
+				if err := s.Insert(key, key); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ResetTimer()
+
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					key := i%keys + 1
+					if i%10 < writes {
+						_ = s.Insert(key, i)
+					} else {
+						_, _ = s.Find(key)
+					}
+					i += 7
+				}
+			})
+		})
+	}
+}
+
+func Benchmark_ReadHeavy(b *testing.B) {
+	benchmarkMix(b, 1)
+}
+
+func Benchmark_Balanced(b *testing.B) {
+	benchmarkMix(b, 5)
+}
+
+func Benchmark_WriteHeavy(b *testing.B) {
+	benchmarkMix(b, 9)
+}
+
+func Example() {
+	m := shardedmap.NewShardedMap[string, int](4)
+	_ = m.Insert("a", 1)
+	v, _ := m.Find("a")
+	fmt.Println(v)
+	// Output: 1
+}
@@ -0,0 +1,186 @@
+package shardedmap
+
+import (
+	"sync"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	seperatechaining "github.com/OladapoAjala/datastructures/hashtables/seperate_chaining"
+)
+
+const (
+	DEFAULT_SHARDS   = 32
+	DEFAULT_CAPACITY = 16
+)
+
+// Table is the non thread-safe hash table each shard guards. Both
+// doublehashing.HashTable and seperatechaining.HashTable satisfy it.
+type Table[K comparable, V any] interface {
+	hashtables.HashTabler[K, V]
+	hashtables.Ranger[K, V]
+}
+
+// ShardedMap is a hash map that is safe for concurrent use. Keys are spread
+// over independently locked shards, so goroutines working on different
+// shards do not contend.
+type ShardedMap[K comparable, V any] struct {
+	shards []*shard[K, V]
+	hasher hashtables.Hasher[K]
+}
+
+type shard[K comparable, V any] struct {
+	mu    sync.RWMutex
+	table Table[K, V]
+}
+
+var (
+	_ hashtables.HashTabler[string, any] = new(ShardedMap[string, any])
+	_ hashtables.Ranger[string, any]     = new(ShardedMap[string, any])
+)
+
+// NewShardedMap builds each shard from a seperatechaining.HashTable. Keys
+// are hashed with a randomly seeded hashtables.MapHasher.
+func NewShardedMap[K comparable, V any](shards int) *ShardedMap[K, V] {
+	hasher := hashtables.NewMapHasher[K]()
+	return NewShardedMapWithTables[K, V](shards, hasher, func() Table[K, V] {
+		return seperatechaining.NewHashTableWithHasher[K, V](DEFAULT_CAPACITY, hasher)
+	})
+}
+
+// NewShardedMapWithTables builds each shard with newTable. hasher picks a
+// key's shard and must be safe for concurrent use, as the built-in hashers
+// are.
+func NewShardedMapWithTables[K comparable, V any](shards int, hasher hashtables.Hasher[K], newTable func() Table[K, V]) *ShardedMap[K, V] {
+	if shards <= 0 {
+		shards = DEFAULT_SHARDS
+	}
+
+	m := &ShardedMap[K, V]{
+		shards: make([]*shard[K, V], shards),
+		hasher: hasher,
+	}
+	for i := range m.shards {
+		m.shards[i] = &shard[K, V]{table: newTable()}
+	}
+	return m
+}
+
+// shard mixes the key's hash so that the shard index does not correlate
+// with the slot the shard's table picks from the same hash.
+func (m *ShardedMap[K, V]) shard(key K) *shard[K, V] {
+	return m.shards[hashtables.Mix(m.hasher.Hash(key))%uint32(len(m.shards))]
+}
+
+func (m *ShardedMap[K, V]) Insert(key K, value V) error {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.table.Insert(key, value)
+}
+
+func (m *ShardedMap[K, V]) Find(key K) (V, error) {
+	s := m.shard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.table.Find(key)
+}
+
+func (m *ShardedMap[K, V]) Delete(key K) error {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.table.Delete(key)
+}
+
+func (m *ShardedMap[K, V]) Upsert(key K, value V) (V, bool, error) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.table.Upsert(key, value)
+}
+
+func (m *ShardedMap[K, V]) GetOrInsert(key K, value V) (V, bool, error) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.table.GetOrInsert(key, value)
+}
+
+// Compute holds the key's shard lock while f runs, so f must not call back
+// into the map.
+func (m *ShardedMap[K, V]) Compute(key K, f func(old V, ok bool) (V, bool)) (V, error) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.table.Compute(key, f)
+}
+
+func (m *ShardedMap[K, V]) Update(key K, value V) error {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.table.Update(key, value)
+}
+
+// Range calls f for each entry until f returns false. Each shard is copied
+// under its read lock and f runs without any lock held, so f may modify the
+// map. Every shard is seen as a consistent snapshot, but changes to shards
+// that have not been visited yet may or may not be observed.
+func (m *ShardedMap[K, V]) Range(f func(K, V) bool) {
+	for _, s := range m.shards {
+		s.mu.RLock()
+		keys := s.table.Keys()
+		values := s.table.Values()
+		s.mu.RUnlock()
+
+		for i := range keys {
+			if !f(keys[i], values[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (m *ShardedMap[K, V]) Keys() []K {
+	keys := []K{}
+	for _, s := range m.shards {
+		s.mu.RLock()
+		keys = append(keys, s.table.Keys()...)
+		s.mu.RUnlock()
+	}
+	return keys
+}
+
+func (m *ShardedMap[K, V]) Values() []V {
+	values := []V{}
+	for _, s := range m.shards {
+		s.mu.RLock()
+		values = append(values, s.table.Values()...)
+		s.mu.RUnlock()
+	}
+	return values
+}
+
+// GetSize sums the shard sizes, locking one shard at a time.
+func (m *ShardedMap[K, V]) GetSize() int32 {
+	var size int32
+	for _, s := range m.shards {
+		s.mu.RLock()
+		size += s.table.GetSize()
+		s.mu.RUnlock()
+	}
+	return size
+}
+
+func (m *ShardedMap[K, V]) GetCapacity() int32 {
+	var capacity int32
+	for _, s := range m.shards {
+		s.mu.RLock()
+		capacity += s.table.GetCapacity()
+		s.mu.RUnlock()
+	}
+	return capacity
+}
+
+func (m *ShardedMap[K, V]) GetShards() int {
+	return len(m.shards)
+}
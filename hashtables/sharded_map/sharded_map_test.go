@@ -0,0 +1,172 @@
+package shardedmap
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	doublehashing "github.com/OladapoAjala/datastructures/hashtables/double_hashing"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMaps() map[string]*ShardedMap[int, int] {
+	hasher := hashtables.NewSipHasher[int](1, 2)
+	return map[string]*ShardedMap[int, int]{
+		"seperate_chaining": NewShardedMap[int, int](8),
+		"double_hashing": NewShardedMapWithTables[int, int](8, hasher, func() Table[int, int] {
+			return doublehashing.NewHashTableWithHasher[int, int](7, hasher)
+		}),
+	}
+}
+
+func Test_NewShardedMap(t *testing.T) {
+	is := assert.New(t)
+
+	is.Equal(DEFAULT_SHARDS, NewShardedMap[string, int](0).GetShards())
+	is.Equal(4, NewShardedMap[string, int](4).GetShards())
+
+	m := NewShardedMap[string, int](4)
+	is.EqualValues(0, m.GetSize())
+	is.EqualValues(4*DEFAULT_CAPACITY, m.GetCapacity())
+}
+
+func Test_Operations(t *testing.T) {
+	is := assert.New(t)
+
+	for name, m := range newMaps() {
+		t.Run(name, func(t *testing.T) {
+			for key := 1; key <= 1000; key++ {
+				is.Nil(m.Insert(key, key*2))
+			}
+			is.EqualValues(1000, m.GetSize())
+
+			value, err := m.Find(500)
+			is.Nil(err)
+			is.Equal(1000, value)
+
+			is.Nil(m.Delete(500))
+			_, err = m.Find(500)
+			is.ErrorIs(err, hashtables.ErrNotFound)
+			is.ErrorIs(m.Delete(500), hashtables.ErrNotFound)
+			is.ErrorIs(m.Insert(0, 1), hashtables.ErrInvalidKey)
+
+			old, ok, err := m.Upsert(1, 3)
+			is.Nil(err)
+			is.True(ok)
+			is.Equal(2, old)
+
+			value, loaded, err := m.GetOrInsert(500, 7)
+			is.Nil(err)
+			is.False(loaded)
+			is.Equal(7, value)
+
+			value, err = m.Compute(500, func(old int, ok bool) (int, bool) {
+				return old + 1, ok
+			})
+			is.Nil(err)
+			is.Equal(8, value)
+
+			is.Nil(m.Update(500, 9))
+			is.ErrorIs(m.Update(5000, 9), hashtables.ErrNotFound)
+
+			keys := m.Keys()
+			sort.Ints(keys)
+			is.Len(keys, 1000)
+			is.Equal(1, keys[0])
+			is.Equal(1000, keys[999])
+			is.Len(m.Values(), 1000)
+		})
+	}
+}
+
+func Test_Range(t *testing.T) {
+	is := assert.New(t)
+
+	for name, m := range newMaps() {
+		t.Run(name, func(t *testing.T) {
+			for key := 1; key <= 100; key++ {
+				is.Nil(m.Insert(key, key))
+			}
+
+			sum := 0
+			m.Range(func(_, v int) bool {
+				sum += v
+				return true
+			})
+			is.Equal(5050, sum)
+
+			visits := 0
+			m.Range(func(int, int) bool {
+				visits++
+				return visits < 10
+			})
+			is.Equal(10, visits)
+
+			// f may modify the map, including the shard being visited.
+			m.Range(func(k, _ int) bool {
+				is.Nil(m.Delete(k))
+				return true
+			})
+			is.EqualValues(0, m.GetSize())
+		})
+	}
+}
+
+func Test_Concurrent(t *testing.T) {
+	is := assert.New(t)
+
+	const goroutines, keys = 16, 500
+
+	for name, m := range newMaps() {
+		t.Run(name, func(t *testing.T) {
+			var wg sync.WaitGroup
+			for g := 0; g < goroutines; g++ {
+				wg.Add(1)
+				go func(g int) {
+					defer wg.Done()
+					for i := 1; i <= keys; i++ {
+						key := g*keys + i
+						if err := m.Insert(key, key); err != nil {
+							t.Error(err)
+						}
+						if _, err := m.Find(key); err != nil {
+							t.Error(err)
+						}
+						if i%2 == 0 {
+							if err := m.Delete(key); err != nil {
+								t.Error(err)
+							}
+						}
+						if _, err := m.Compute(-1, func(old int, _ bool) (int, bool) {
+							return old + 1, true
+						}); err != nil {
+							t.Error(err)
+						}
+					}
+				}(g)
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 20; i++ {
+					m.Range(func(int, int) bool { return true })
+				}
+			}()
+			wg.Wait()
+
+			is.EqualValues(goroutines*keys/2+1, m.GetSize())
+			counter, err := m.Find(-1)
+			is.Nil(err)
+			is.Equal(goroutines*keys, counter)
+
+			for g := 0; g < goroutines; g++ {
+				value, err := m.Find(g*keys + 1)
+				is.Nil(err, fmt.Sprint(g))
+				is.Equal(g*keys+1, value)
+			}
+		})
+	}
+}
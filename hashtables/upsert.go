@@ -0,0 +1,64 @@
+package hashtables
+
+import "errors"
+
+// Table is the part of HashTabler the upsert helpers are built on. Tables
+// implement Upsert, GetOrInsert, Compute and Update by calling the helpers
+// with themselves.
+type Table[K comparable, V any] interface {
+	Insert(K, V) error
+	Find(K) (V, error)
+	Delete(K) error
+}
+
+// lookup is Find that reports a missing key as ok == false rather than as an
+// error.
+func lookup[K comparable, V any](t Table[K, V], key K) (V, bool, error) {
+	value, err := t.Find(key)
+	if errors.Is(err, ErrNotFound) {
+		return *new(V), false, nil
+	}
+	if err != nil {
+		return *new(V), false, err
+	}
+	return value, true, nil
+}
+
+func Upsert[K comparable, V any](t Table[K, V], key K, value V) (V, bool, error) {
+	old, ok, err := lookup(t, key)
+	if err != nil {
+		return *new(V), false, err
+	}
+	return old, ok, t.Insert(key, value)
+}
+
+func GetOrInsert[K comparable, V any](t Table[K, V], key K, value V) (V, bool, error) {
+	old, ok, err := lookup(t, key)
+	if err != nil || ok {
+		return old, ok, err
+	}
+	return value, false, t.Insert(key, value)
+}
+
+func Compute[K comparable, V any](t Table[K, V], key K, f func(old V, ok bool) (V, bool)) (V, error) {
+	old, ok, err := lookup(t, key)
+	if err != nil {
+		return *new(V), err
+	}
+
+	value, keep := f(old, ok)
+	if !keep {
+		if !ok {
+			return *new(V), nil
+		}
+		return *new(V), t.Delete(key)
+	}
+	return value, t.Insert(key, value)
+}
+
+func Update[K comparable, V any](t Table[K, V], key K, value V) error {
+	if _, err := t.Find(key); err != nil {
+		return err
+	}
+	return t.Insert(key, value)
+}
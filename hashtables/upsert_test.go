@@ -0,0 +1,168 @@
+package hashtables_test
+
+import (
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Upsert(t *testing.T) {
+	is := assert.New(t)
+
+	for _, tt := range tables {
+		t.Run(tt.name, func(t *testing.T) {
+			ht := tt.new(7)
+
+			old, ok, err := ht.Upsert(1, 10)
+			is.Nil(err)
+			is.False(ok)
+			is.Zero(old)
+
+			old, ok, err = ht.Upsert(1, 11)
+			is.Nil(err)
+			is.True(ok)
+			is.Equal(10, old)
+			is.EqualValues(1, ht.GetSize())
+
+			for key := 2; key <= 50; key++ {
+				_, ok, err = ht.Upsert(key, key)
+				is.Nil(err)
+				is.False(ok)
+			}
+			is.EqualValues(50, ht.GetSize())
+
+			value, err := ht.Find(1)
+			is.Nil(err)
+			is.Equal(11, value)
+
+			_, _, err = ht.Upsert(0, 1)
+			is.ErrorIs(err, hashtables.ErrInvalidKey)
+		})
+	}
+}
+
+func Test_GetOrInsert(t *testing.T) {
+	is := assert.New(t)
+
+	for _, tt := range tables {
+		t.Run(tt.name, func(t *testing.T) {
+			ht := tt.new(7)
+
+			value, loaded, err := ht.GetOrInsert(1, 10)
+			is.Nil(err)
+			is.False(loaded)
+			is.Equal(10, value)
+
+			value, loaded, err = ht.GetOrInsert(1, 20)
+			is.Nil(err)
+			is.True(loaded)
+			is.Equal(10, value)
+			is.EqualValues(1, ht.GetSize())
+
+			_, _, err = ht.GetOrInsert(0, 1)
+			is.ErrorIs(err, hashtables.ErrInvalidKey)
+		})
+	}
+}
+
+func Test_Compute(t *testing.T) {
+	is := assert.New(t)
+
+	increment := func(old int, ok bool) (int, bool) {
+		return old + 1, true
+	}
+	remove := func(int, bool) (int, bool) {
+		return 0, false
+	}
+
+	for _, tt := range tables {
+		t.Run(tt.name, func(t *testing.T) {
+			ht := tt.new(7)
+
+			for i := 0; i < 3; i++ {
+				for key := 1; key <= 20; key++ {
+					_, err := ht.Compute(key, increment)
+					is.Nil(err)
+				}
+			}
+			is.EqualValues(20, ht.GetSize())
+			value, err := ht.Find(7)
+			is.Nil(err)
+			is.Equal(3, value)
+
+			var seen []bool
+			value, err = ht.Compute(7, func(old int, ok bool) (int, bool) {
+				seen = append(seen, ok)
+				return old * 10, true
+			})
+			is.Nil(err)
+			is.Equal(30, value)
+
+			value, err = ht.Compute(7, remove)
+			is.Nil(err)
+			is.Zero(value)
+			_, err = ht.Find(7)
+			is.ErrorIs(err, hashtables.ErrNotFound)
+			is.EqualValues(19, ht.GetSize())
+
+			_, err = ht.Compute(100, func(old int, ok bool) (int, bool) {
+				seen = append(seen, ok)
+				return 0, false
+			})
+			is.Nil(err)
+			is.Equal([]bool{true, false}, seen)
+			is.EqualValues(19, ht.GetSize())
+
+			_, err = ht.Compute(0, increment)
+			is.ErrorIs(err, hashtables.ErrInvalidKey)
+		})
+	}
+}
+
+func Test_Update(t *testing.T) {
+	is := assert.New(t)
+
+	for _, tt := range tables {
+		t.Run(tt.name, func(t *testing.T) {
+			ht := tt.new(7)
+
+			err := ht.Update(1, 10)
+			is.ErrorIs(err, hashtables.ErrNotFound)
+			is.EqualError(err, "key 1 not found in hashtable")
+			is.EqualValues(0, ht.GetSize())
+
+			is.Nil(ht.Insert(1, 10))
+			is.Nil(ht.Update(1, 11))
+			value, err := ht.Find(1)
+			is.Nil(err)
+			is.Equal(11, value)
+
+			is.ErrorIs(ht.Update(0, 1), hashtables.ErrInvalidKey)
+		})
+	}
+}
+
+func Test_SentinelErrors(t *testing.T) {
+	is := assert.New(t)
+
+	for _, tt := range tables {
+		t.Run(tt.name, func(t *testing.T) {
+			ht := tt.new(7)
+			is.Nil(ht.Insert(1, 1))
+
+			is.ErrorIs(ht.Insert(0, 1), hashtables.ErrInvalidKey)
+			_, err := ht.Find(0)
+			is.ErrorIs(err, hashtables.ErrInvalidKey)
+			is.ErrorIs(ht.Delete(0), hashtables.ErrInvalidKey)
+			_, err = ht.ProbeLength(0)
+			is.ErrorIs(err, hashtables.ErrInvalidKey)
+
+			_, err = ht.Find(2)
+			is.ErrorIs(err, hashtables.ErrNotFound)
+			is.ErrorIs(ht.Delete(2), hashtables.ErrNotFound)
+			_, err = ht.ProbeLength(2)
+			is.ErrorIs(err, hashtables.ErrNotFound)
+		})
+	}
+}
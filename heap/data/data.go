@@ -8,6 +8,7 @@ type Data[K constraints.Ordered, V comparable] struct {
 	Key   K
 	Value V
 	Index int32
+	Seq   uint64
 }
 
 type IData[K constraints.Ordered, V comparable] interface {
@@ -38,6 +39,10 @@ func (n *Data[K, V]) GetIndex() int32 {
 	return n.Index
 }
 
+func (n *Data[K, V]) GetSeq() uint64 {
+	return n.Seq
+}
+
 func (n *Data[K, V]) GetParentIndex() int32 {
 	return (n.Index - 1) / 2
 }
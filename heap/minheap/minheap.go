@@ -10,7 +10,9 @@ import (
 )
 
 type MinHeap[K constraints.Ordered, V comparable] struct {
-	Heap *dynamicarray.DynamicArray[*data.Data[K, V]]
+	Heap   *dynamicarray.DynamicArray[*data.Data[K, V]]
+	stable bool
+	seq    uint64
 }
 
 type MinHeaper[K constraints.Ordered, V comparable] interface {
@@ -27,9 +29,20 @@ func NewMinHeap[K constraints.Ordered, V comparable]() *MinHeap[K, V] {
 	}
 }
 
+// NewStableMinHeap returns a heap that breaks ties between equal keys by
+// insertion order, so items with the same key come out first-in first-out.
+func NewStableMinHeap[K constraints.Ordered, V comparable]() *MinHeap[K, V] {
+	return &MinHeap[K, V]{
+		Heap:   dynamicarray.NewDynamicArray[*data.Data[K, V]](),
+		stable: true,
+	}
+}
+
 func (mh *MinHeap[K, V]) Insert(key K, value V) error {
 	index := mh.Heap.GetSize()
 	d := data.NewData[K, V](key, value, index)
+	d.Seq = mh.seq
+	mh.seq++
 	err := mh.Heap.InsertLast(d)
 	if err != nil {
 		return err
@@ -77,9 +90,10 @@ func (mh *MinHeap[K, V]) heapifyUp(d *data.Data[K, V]) error {
 	if err != nil {
 		return err
 	}
-	if d.GetKey() < parent.GetKey() {
-		mh.swap(d, parent)
+	if !mh.lower(d, parent) {
+		return nil
 	}
+	mh.swap(d, parent)
 	return mh.heapifyUp(parent)
 }
 
@@ -88,26 +102,36 @@ func (mh *MinHeap[K, V]) heapifyDown(d *data.Data[K, V]) error {
 		return nil
 	}
 
+	next := d
 	left, err := mh.GetLeft(d)
-	if err != nil {
-		return nil
+	if err == nil && mh.lower(left, next) {
+		next = left
 	}
 	right, err := mh.GetRight(d)
-	if err != nil {
+	if err == nil && mh.lower(right, next) {
+		next = right
+	}
+
+	if next == d {
 		return nil
 	}
+	mh.swap(d, next)
+	return mh.heapifyDown(next)
+}
 
-	if right.GetKey() < left.GetKey() {
-		mh.swap(d, right)
-		return mh.heapifyDown(right)
+// lower reports whether a belongs above b. In stable mode equal keys are
+// ordered by insertion sequence.
+func (mh *MinHeap[K, V]) lower(a, b *data.Data[K, V]) bool {
+	if a.GetKey() != b.GetKey() {
+		return a.GetKey() < b.GetKey()
 	}
-	mh.swap(d, left)
-	return mh.heapifyDown(left)
+	return mh.stable && a.GetSeq() < b.GetSeq()
 }
 
 func (mh *MinHeap[K, V]) swap(a, b *data.Data[K, V]) {
 	a.Key, b.Key = b.Key, a.Key
 	a.Value, b.Value = b.Value, a.Value
+	a.Seq, b.Seq = b.Seq, a.Seq
 }
 
 func (mh *MinHeap[K, V]) GetParent(n *data.Data[K, V]) (*data.Data[K, V], error) {
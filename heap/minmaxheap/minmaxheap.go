@@ -0,0 +1,231 @@
+package minmaxheap
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/OladapoAjala/datastructures/heap"
+	"github.com/OladapoAjala/datastructures/heap/data"
+	"github.com/OladapoAjala/datastructures/sequences/dynamicarray"
+	"golang.org/x/exp/constraints"
+)
+
+// MinMaxHeap keeps the minimum on the even (min) levels and the maximum on
+// the odd (max) levels, so both ends can be found and removed in O(log n).
+type MinMaxHeap[K constraints.Ordered, V comparable] struct {
+	Heap *dynamicarray.DynamicArray[*data.Data[K, V]]
+}
+
+type MinMaxHeaper[K constraints.Ordered, V comparable] interface {
+	heap.Heaper[K, V]
+	FindMin() (*data.Data[K, V], error)
+	FindMax() (*data.Data[K, V], error)
+	DeleteMin() (*data.Data[K, V], error)
+	DeleteMax() (*data.Data[K, V], error)
+}
+
+var _ MinMaxHeaper[string, string] = new(MinMaxHeap[string, string])
+
+func NewMinMaxHeap[K constraints.Ordered, V comparable]() *MinMaxHeap[K, V] {
+	return &MinMaxHeap[K, V]{
+		Heap: dynamicarray.NewDynamicArray[*data.Data[K, V]](),
+	}
+}
+
+func (mh *MinMaxHeap[K, V]) Insert(key K, value V) error {
+	index := mh.Heap.GetSize()
+	d := data.NewData[K, V](key, value, index)
+	err := mh.Heap.InsertLast(d)
+	if err != nil {
+		return err
+	}
+	mh.heapifyUp(index)
+	return nil
+}
+
+func (mh *MinMaxHeap[K, V]) FindMin() (*data.Data[K, V], error) {
+	if mh.IsEmpty() {
+		return nil, fmt.Errorf("empty heap")
+	}
+	return mh.Heap.GetData(0)
+}
+
+func (mh *MinMaxHeap[K, V]) FindMax() (*data.Data[K, V], error) {
+	if mh.IsEmpty() {
+		return nil, fmt.Errorf("empty heap")
+	}
+	return mh.Heap.GetData(mh.maxIndex())
+}
+
+func (mh *MinMaxHeap[K, V]) DeleteMin() (*data.Data[K, V], error) {
+	if mh.IsEmpty() {
+		return nil, fmt.Errorf("empty heap")
+	}
+	return mh.delete(0)
+}
+
+func (mh *MinMaxHeap[K, V]) DeleteMax() (*data.Data[K, V], error) {
+	if mh.IsEmpty() {
+		return nil, fmt.Errorf("empty heap")
+	}
+	return mh.delete(mh.maxIndex())
+}
+
+func (mh *MinMaxHeap[K, V]) delete(index int32) (*data.Data[K, V], error) {
+	last, err := mh.Heap.GetData(mh.Heap.GetSize() - 1)
+	if err != nil {
+		return nil, err
+	}
+	if last.Index != index {
+		mh.swap(mh.get(index), last)
+	}
+	err = mh.Heap.DeleteLast()
+	if err != nil {
+		return nil, err
+	}
+	if index < mh.Heap.GetSize() {
+		mh.heapifyDown(index)
+	}
+	return last, nil
+}
+
+// maxIndex is the position of the largest key, one of the root's children
+// unless the heap holds a single item.
+func (mh *MinMaxHeap[K, V]) maxIndex() int32 {
+	switch mh.Heap.GetSize() {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	}
+	if mh.get(2).Key > mh.get(1).Key {
+		return 2
+	}
+	return 1
+}
+
+func (mh *MinMaxHeap[K, V]) heapifyUp(index int32) {
+	if index == 0 {
+		return
+	}
+	d := mh.get(index)
+	parent := mh.get(d.GetParentIndex())
+
+	if isMinLevel(index) {
+		if d.Key > parent.Key {
+			mh.swap(d, parent)
+			mh.heapifyUpLevel(parent.Index, greater[K])
+			return
+		}
+		mh.heapifyUpLevel(index, less[K])
+		return
+	}
+
+	if d.Key < parent.Key {
+		mh.swap(d, parent)
+		mh.heapifyUpLevel(parent.Index, less[K])
+		return
+	}
+	mh.heapifyUpLevel(index, greater[K])
+}
+
+// heapifyUpLevel bubbles the item at index up through its grandparents,
+// i.e. along the levels of the same kind (min or max).
+func (mh *MinMaxHeap[K, V]) heapifyUpLevel(index int32, before func(a, b K) bool) {
+	for index > 2 {
+		d := mh.get(index)
+		grandparent := mh.get(mh.get(d.GetParentIndex()).GetParentIndex())
+		if !before(d.Key, grandparent.Key) {
+			return
+		}
+		mh.swap(d, grandparent)
+		index = grandparent.Index
+	}
+}
+
+func (mh *MinMaxHeap[K, V]) heapifyDown(index int32) {
+	if isMinLevel(index) {
+		mh.heapifyDownLevel(index, less[K])
+		return
+	}
+	mh.heapifyDownLevel(index, greater[K])
+}
+
+// heapifyDownLevel trickles the item at index down. before is less on min
+// levels and greater on max levels.
+func (mh *MinMaxHeap[K, V]) heapifyDownLevel(index int32, before func(a, b K) bool) {
+	for {
+		d := mh.get(index)
+		m := mh.bestDescendant(d, before)
+		if m == nil {
+			return
+		}
+
+		if m.GetParentIndex() == index {
+			if before(m.Key, d.Key) {
+				mh.swap(m, d)
+			}
+			return
+		}
+
+		if !before(m.Key, d.Key) {
+			return
+		}
+		mh.swap(m, d)
+		parent := mh.get(m.GetParentIndex())
+		if before(parent.Key, m.Key) {
+			mh.swap(m, parent)
+		}
+		index = m.Index
+	}
+}
+
+// bestDescendant returns the child or grandchild of d that should come first
+// according to before, or nil if d is a leaf.
+func (mh *MinMaxHeap[K, V]) bestDescendant(d *data.Data[K, V], before func(a, b K) bool) *data.Data[K, V] {
+	left := d.GetLeftIndex()
+	candidates := []int32{left, left + 1, 2*left + 1, 2*left + 2, 2*left + 3, 2*left + 4}
+
+	var best *data.Data[K, V]
+	for _, i := range candidates {
+		if i >= mh.Heap.GetSize() {
+			break
+		}
+		c := mh.get(i)
+		if best == nil || before(c.Key, best.Key) {
+			best = c
+		}
+	}
+	return best
+}
+
+func (mh *MinMaxHeap[K, V]) get(index int32) *data.Data[K, V] {
+	d, _ := mh.Heap.GetData(index)
+	return d
+}
+
+func (mh *MinMaxHeap[K, V]) swap(a, b *data.Data[K, V]) {
+	a.Key, b.Key = b.Key, a.Key
+	a.Value, b.Value = b.Value, a.Value
+	a.Seq, b.Seq = b.Seq, a.Seq
+}
+
+func (mh *MinMaxHeap[K, V]) GetSize() int32 {
+	return mh.Heap.GetSize()
+}
+
+func (mh *MinMaxHeap[K, V]) IsEmpty() bool {
+	return mh.Heap.GetSize() == 0
+}
+
+func isMinLevel(index int32) bool {
+	return (bits.Len32(uint32(index+1))-1)%2 == 0
+}
+
+func less[K constraints.Ordered](a, b K) bool {
+	return a < b
+}
+
+func greater[K constraints.Ordered](a, b K) bool {
+	return a > b
+}
@@ -0,0 +1,227 @@
+package minmaxheap
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/heap/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Insert(t *testing.T) {
+	is := assert.New(t)
+	mh := NewMinMaxHeap[int, string]()
+
+	tests := []struct {
+		name string
+		key  int
+		val  string
+		want func(error)
+	}{
+		{
+			name: "Insert into an empty heap",
+			key:  5,
+			val:  "value5",
+			want: func(err error) {
+				is.Nil(err)
+				is.EqualValues(1, mh.GetSize())
+
+				min, err := mh.FindMin()
+				is.Nil(err)
+				is.Equal("value5", min.Value)
+				max, err := mh.FindMax()
+				is.Nil(err)
+				is.Equal("value5", max.Value)
+			},
+		},
+		{
+			name: "Insert a new max",
+			key:  9,
+			val:  "value9",
+			want: func(err error) {
+				is.Nil(err)
+				is.EqualValues(2, mh.GetSize())
+
+				min, err := mh.FindMin()
+				is.Nil(err)
+				is.Equal("value5", min.Value)
+				max, err := mh.FindMax()
+				is.Nil(err)
+				is.Equal("value9", max.Value)
+				is.EqualValues(1, max.Index)
+			},
+		},
+		{
+			name: "Insert a new min",
+			key:  1,
+			val:  "value1",
+			want: func(err error) {
+				is.Nil(err)
+				is.EqualValues(3, mh.GetSize())
+
+				min, err := mh.FindMin()
+				is.Nil(err)
+				is.Equal("value1", min.Value)
+				is.EqualValues(0, min.Index)
+				max, err := mh.FindMax()
+				is.Nil(err)
+				is.Equal("value9", max.Value)
+			},
+		},
+		{
+			name: "Insert on a min level above the max",
+			key:  12,
+			val:  "value12",
+			want: func(err error) {
+				is.Nil(err)
+				is.EqualValues(4, mh.GetSize())
+
+				max, err := mh.FindMax()
+				is.Nil(err)
+				is.Equal("value12", max.Value)
+				is.EqualValues(1, max.Index)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := mh.Insert(tt.key, tt.val)
+			tt.want(err)
+		})
+	}
+}
+
+func Test_DeleteMin(t *testing.T) {
+	is := assert.New(t)
+	mh := NewMinMaxHeap[int, string]()
+
+	tests := []struct {
+		name string
+		keys []int
+		want func(*data.Data[int, string], error)
+	}{
+		{
+			name: "DeleteMin from an empty heap",
+			want: func(min *data.Data[int, string], err error) {
+				is.Error(err, "empty heap")
+				is.Nil(min)
+			},
+		},
+		{
+			name: "DeleteMin from a non-empty heap",
+			keys: []int{3, 1, 5, 2, 4, 7},
+			want: func(min *data.Data[int, string], err error) {
+				is.Nil(err)
+				is.Equal("value1", min.Value)
+			},
+		},
+		{
+			name: "DeleteMin again",
+			want: func(min *data.Data[int, string], err error) {
+				is.Nil(err)
+				is.Equal("value2", min.Value)
+
+				max, err := mh.FindMax()
+				is.Nil(err)
+				is.Equal("value7", max.Value)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range tt.keys {
+				is.Nil(mh.Insert(key, fmt.Sprintf("value%d", key)))
+			}
+			min, err := mh.DeleteMin()
+			tt.want(min, err)
+		})
+	}
+}
+
+func Test_DeleteMax(t *testing.T) {
+	is := assert.New(t)
+	mh := NewMinMaxHeap[int, string]()
+
+	tests := []struct {
+		name string
+		keys []int
+		want func(*data.Data[int, string], error)
+	}{
+		{
+			name: "DeleteMax from an empty heap",
+			want: func(max *data.Data[int, string], err error) {
+				is.Error(err, "empty heap")
+				is.Nil(max)
+			},
+		},
+		{
+			name: "DeleteMax from heap with one element",
+			keys: []int{3},
+			want: func(max *data.Data[int, string], err error) {
+				is.Nil(err)
+				is.Equal("value3", max.Value)
+				is.True(mh.IsEmpty())
+			},
+		},
+		{
+			name: "DeleteMax from a non-empty heap",
+			keys: []int{3, 1, 5, 2, 4, 7},
+			want: func(max *data.Data[int, string], err error) {
+				is.Nil(err)
+				is.Equal("value7", max.Value)
+			},
+		},
+		{
+			name: "DeleteMax again",
+			want: func(max *data.Data[int, string], err error) {
+				is.Nil(err)
+				is.Equal("value5", max.Value)
+
+				min, err := mh.FindMin()
+				is.Nil(err)
+				is.Equal("value1", min.Value)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range tt.keys {
+				is.Nil(mh.Insert(key, fmt.Sprintf("value%d", key)))
+			}
+			max, err := mh.DeleteMax()
+			tt.want(max, err)
+		})
+	}
+}
+
+func Test_RandomOperations(t *testing.T) {
+	is := assert.New(t)
+	mh := NewMinMaxHeap[int, int]()
+	rng := rand.New(rand.NewSource(26))
+	sorted := make([]int, 0)
+
+	for i := 0; i < 5000; i++ {
+		switch op := rng.Intn(4); {
+		case op < 2 || len(sorted) == 0:
+			key := rng.Intn(1000)
+			is.Nil(mh.Insert(key, key))
+			sorted = append(sorted, key)
+			sort.Ints(sorted)
+		case op == 2:
+			min, err := mh.DeleteMin()
+			is.Nil(err)
+			is.Equal(sorted[0], min.Key)
+			sorted = sorted[1:]
+		default:
+			max, err := mh.DeleteMax()
+			is.Nil(err)
+			is.Equal(sorted[len(sorted)-1], max.Key)
+			sorted = sorted[:len(sorted)-1]
+		}
+		is.EqualValues(len(sorted), mh.GetSize())
+	}
+}
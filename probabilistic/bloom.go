@@ -0,0 +1,157 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+)
+
+// BloomFilter sets k bits per key in an array of m bits. Contains reports a
+// key as present when all of its bits are set, which for a key that was
+// never inserted happens with probability about (1 - e^(-kn/m))^k after n
+// inserts.
+type BloomFilter[K comparable] struct {
+	bits   []uint64
+	m      uint32
+	k      uint32
+	count  uint32
+	hasher hashtables.Hasher[K]
+}
+
+var _ Filter[string] = new(BloomFilter[string])
+
+// OptimalBloomSize returns the number of bits m and hash functions k that
+// give a false positive rate of p once n keys are inserted:
+// m = -n ln p / (ln 2)^2 and k = (m/n) ln 2. It returns ErrInvalidConfig
+// when n is 0, p is outside (0, 1), or m does not fit in a uint32.
+func OptimalBloomSize(n uint32, p float64) (uint32, uint32, error) {
+	if n == 0 || p <= 0 || p >= 1 {
+		return 0, 0, ErrInvalidConfig
+	}
+	m := math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2))
+	if m > math.MaxUint32 {
+		return 0, 0, ErrInvalidConfig
+	}
+	k := math.Round(m / float64(n) * math.Ln2)
+	if k < 1 {
+		k = 1
+	}
+	return uint32(m), uint32(k), nil
+}
+
+// NewBloomFilter sizes the filter to hold n keys at a false positive rate
+// of p.
+func NewBloomFilter[K comparable](n uint32, p float64) (*BloomFilter[K], error) {
+	return NewBloomFilterWithHasher[K](n, p, hashtables.FNVHasher[K]{})
+}
+
+func NewBloomFilterWithHasher[K comparable](n uint32, p float64, hasher hashtables.Hasher[K]) (*BloomFilter[K], error) {
+	m, k, err := OptimalBloomSize(n, p)
+	if err != nil {
+		return nil, err
+	}
+	return newBloomFilter(m, k, hasher), nil
+}
+
+func newBloomFilter[K comparable](m, k uint32, hasher hashtables.Hasher[K]) *BloomFilter[K] {
+	return &BloomFilter[K]{
+		bits:   make([]uint64, words(m)),
+		m:      m,
+		k:      k,
+		hasher: hasher,
+	}
+}
+
+func (f *BloomFilter[K]) Insert(key K) error {
+	h1, h2 := hashes(f.hasher, key)
+	for i := uint32(0); i < f.k; i++ {
+		p := position(h1, h2, i, f.m)
+		f.bits[p/64] |= 1 << (p % 64)
+	}
+	f.count++
+	return nil
+}
+
+func (f *BloomFilter[K]) Contains(key K) bool {
+	h1, h2 := hashes(f.hasher, key)
+	for i := uint32(0); i < f.k; i++ {
+		p := position(h1, h2, i, f.m)
+		if f.bits[p/64]&(1<<(p%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Union adds every key of other, which must have the same shape and hasher.
+// The result is the filter both sets of keys would have built.
+func (f *BloomFilter[K]) Union(other *BloomFilter[K]) error {
+	if f.m != other.m || f.k != other.k {
+		return ErrIncompatible
+	}
+	for i := range f.bits {
+		f.bits[i] |= other.bits[i]
+	}
+	f.count += other.count
+	return nil
+}
+
+// EstimatedFalsePositiveRate is the expected false positive rate for the
+// number of inserts so far. Inserting a key twice counts twice, so it errs
+// high.
+func (f *BloomFilter[K]) EstimatedFalsePositiveRate() float64 {
+	return math.Pow(1-math.Exp(-float64(f.k)*float64(f.count)/float64(f.m)), float64(f.k))
+}
+
+func (f *BloomFilter[K]) MarshalBinary() ([]byte, error) {
+	out := header('B', f.m, f.k, f.count)
+	buf := make([]byte, 8*len(f.bits))
+	for i, word := range f.bits {
+		binary.LittleEndian.PutUint64(buf[8*i:], word)
+	}
+	return append(out, buf...), nil
+}
+
+// UnmarshalBinary replaces the filter's contents and shape. The filter
+// keeps its own hasher, which must match the one that built the data.
+func (f *BloomFilter[K]) UnmarshalBinary(data []byte) error {
+	fields, payload, err := readHeader(data, 'B', 3)
+	if err != nil {
+		return err
+	}
+	m, k, count := fields[0], fields[1], fields[2]
+	if m == 0 || k == 0 || len(payload) != 8*words(m) {
+		return ErrInvalidData
+	}
+
+	if f.hasher == nil {
+		f.hasher = hashtables.FNVHasher[K]{}
+	}
+	f.m, f.k, f.count = m, k, count
+	f.bits = make([]uint64, words(m))
+	for i := range f.bits {
+		f.bits[i] = binary.LittleEndian.Uint64(payload[8*i:])
+	}
+	return nil
+}
+
+// words is the number of uint64s that hold m bits, computed without
+// overflowing for m near math.MaxUint32.
+func words(m uint32) int {
+	return int((uint64(m) + 63) / 64)
+}
+
+// GetBits returns m, the number of bits in the filter.
+func (f *BloomFilter[K]) GetBits() uint32 {
+	return f.m
+}
+
+// GetHashes returns k, the number of bits set per key.
+func (f *BloomFilter[K]) GetHashes() uint32 {
+	return f.k
+}
+
+func (f *BloomFilter[K]) GetCount() uint32 {
+	return f.count
+}
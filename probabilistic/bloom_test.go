@@ -0,0 +1,141 @@
+package probabilistic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/stretchr/testify/assert"
+)
+
+// falsePositiveRate checks absent keys n+1..n+trials against contains.
+func falsePositiveRate(contains func(int) bool, n, trials int) float64 {
+	positives := 0
+	for key := n + 1; key <= n+trials; key++ {
+		if contains(key) {
+			positives++
+		}
+	}
+	return float64(positives) / float64(trials)
+}
+
+func Test_OptimalBloomSize(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		n    uint32
+		p    float64
+		m, k uint32
+	}{
+		{n: 1000, p: 0.01, m: 9586, k: 7},
+		{n: 1000, p: 0.001, m: 14378, k: 10},
+		{n: 1, p: 0.5, m: 2, k: 1},
+		{n: 100, p: 0.9, m: 22, k: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("n=%d/p=%v", tt.n, tt.p), func(t *testing.T) {
+			m, k, err := OptimalBloomSize(tt.n, tt.p)
+			is.Nil(err)
+			is.Equal(tt.m, m)
+			is.Equal(tt.k, k)
+		})
+	}
+
+	// About 9.3e10 bits, which does not fit in a uint32.
+	_, _, err := OptimalBloomSize(1<<31, 1e-9)
+	is.ErrorIs(err, ErrInvalidConfig)
+	_, err = NewBloomFilter[int](1<<31, 1e-9)
+	is.ErrorIs(err, ErrInvalidConfig)
+	_, err = NewCountingBloomFilter[int](1<<31, 1e-9)
+	is.ErrorIs(err, ErrInvalidConfig)
+}
+
+func Test_BloomFilter(t *testing.T) {
+	is := assert.New(t)
+
+	for _, p := range []float64{0.1, 0.01, 0.001} {
+		t.Run(fmt.Sprintf("p=%v", p), func(t *testing.T) {
+			const n = 10000
+			f, err := NewBloomFilter[int](n, p)
+			is.Nil(err)
+
+			for key := 1; key <= n; key++ {
+				is.Nil(f.Insert(key))
+			}
+			for key := 1; key <= n; key++ {
+				is.True(f.Contains(key))
+			}
+
+			rate := falsePositiveRate(f.Contains, n, 100000)
+			is.Less(rate, 1.5*p)
+			is.InDelta(p, f.EstimatedFalsePositiveRate(), p/5)
+			is.EqualValues(n, f.GetCount())
+		})
+	}
+}
+
+func Test_BloomFilterConfig(t *testing.T) {
+	is := assert.New(t)
+
+	for _, tt := range []struct {
+		n uint32
+		p float64
+	}{{0, 0.1}, {10, 0}, {10, 1}, {10, -0.5}} {
+		_, err := NewBloomFilter[int](tt.n, tt.p)
+		is.ErrorIs(err, ErrInvalidConfig)
+		_, err = NewCountingBloomFilter[int](tt.n, tt.p)
+		is.ErrorIs(err, ErrInvalidConfig)
+	}
+}
+
+func Test_BloomFilterUnion(t *testing.T) {
+	is := assert.New(t)
+
+	a, _ := NewBloomFilter[string](100, 0.01)
+	b, _ := NewBloomFilter[string](100, 0.01)
+	is.Nil(a.Insert("a"))
+	is.Nil(b.Insert("b"))
+
+	is.Nil(a.Union(b))
+	is.True(a.Contains("a"))
+	is.True(a.Contains("b"))
+	is.EqualValues(2, a.GetCount())
+
+	c, _ := NewBloomFilter[string](1000, 0.01)
+	is.ErrorIs(a.Union(c), ErrIncompatible)
+}
+
+func Test_BloomFilterSerialisation(t *testing.T) {
+	is := assert.New(t)
+
+	hasher := hashtables.NewSipHasher[string](1, 2)
+	f, _ := NewBloomFilterWithHasher[string](500, 0.01, hasher)
+	for i := 0; i < 500; i++ {
+		is.Nil(f.Insert(fmt.Sprint("key", i)))
+	}
+
+	data, err := f.MarshalBinary()
+	is.Nil(err)
+
+	g, _ := NewBloomFilterWithHasher[string](1, 0.5, hasher)
+	is.Nil(g.UnmarshalBinary(data))
+	is.Equal(f.GetBits(), g.GetBits())
+	is.Equal(f.GetHashes(), g.GetHashes())
+	is.Equal(f.GetCount(), g.GetCount())
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprint("key", i)
+		is.Equal(f.Contains(key), g.Contains(key))
+	}
+
+	// A zero filter reads the data with the default hasher.
+	var h BloomFilter[int]
+	data, _ = newBloomFilter[int](64, 3, hashtables.FNVHasher[int]{}).MarshalBinary()
+	is.Nil(h.UnmarshalBinary(data))
+	is.False(h.Contains(1))
+
+	is.ErrorIs(g.UnmarshalBinary(nil), ErrInvalidData)
+	is.ErrorIs(g.UnmarshalBinary(data[:len(data)-1]), ErrInvalidData)
+	data[0] = 'C'
+	is.ErrorIs(g.UnmarshalBinary(data), ErrInvalidData)
+}
@@ -0,0 +1,214 @@
+package probabilistic
+
+import (
+	"math"
+	"sort"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/OladapoAjala/datastructures/heap/minheap"
+)
+
+// CountMinSketch estimates how often each key was added using a fixed
+// d x w grid of counters. A key adds to one counter per row, and its
+// estimate is the smallest of them.
+//
+// Estimates never undercount. With w = ceil(e/epsilon) and
+// d = ceil(ln(1/delta)), an estimate exceeds the true count by more than
+// epsilon*N, where N is the total of all adds, with probability at most
+// delta.
+//
+// With topK above zero the sketch also tracks the keys with the highest
+// estimates, using a min-heap whose root is the smallest tracked estimate
+// and so the next to be displaced.
+type CountMinSketch[K comparable] struct {
+	counters []uint64
+	width    uint32
+	depth    uint32
+	total    uint64
+	hasher   hashtables.Hasher[K]
+
+	topK    int
+	tracked map[K]uint64
+	// heap holds an entry for every estimate a tracked key has had. Entries
+	// whose key is no longer tracked at that estimate are stale and are
+	// dropped when they reach the root.
+	heap *minheap.MinHeap[uint64, K]
+}
+
+// HeavyHitter is a key and its estimated count.
+type HeavyHitter[K comparable] struct {
+	Key   K
+	Count uint64
+}
+
+func NewCountMinSketch[K comparable](epsilon, delta float64, topK int) (*CountMinSketch[K], error) {
+	return NewCountMinSketchWithHasher[K](epsilon, delta, topK, hashtables.FNVHasher[K]{})
+}
+
+func NewCountMinSketchWithHasher[K comparable](epsilon, delta float64, topK int, hasher hashtables.Hasher[K]) (*CountMinSketch[K], error) {
+	if epsilon <= 0 || epsilon >= 1 || delta <= 0 || delta >= 1 || topK < 0 {
+		return nil, ErrInvalidConfig
+	}
+	width := uint32(math.Ceil(math.E / epsilon))
+	depth := uint32(math.Ceil(math.Log(1 / delta)))
+
+	return &CountMinSketch[K]{
+		counters: make([]uint64, width*depth),
+		width:    width,
+		depth:    depth,
+		hasher:   hasher,
+		topK:     topK,
+		tracked:  make(map[K]uint64),
+		heap:     minheap.NewMinHeap[uint64, K](),
+	}, nil
+}
+
+// Add records count more occurrences of key.
+func (s *CountMinSketch[K]) Add(key K, count uint64) error {
+	h1, h2 := hashes(s.hasher, key)
+	estimate := uint64(math.MaxUint64)
+	for row := uint32(0); row < s.depth; row++ {
+		c := &s.counters[row*s.width+position(h1, h2, row, s.width)]
+		*c += count
+		if *c < estimate {
+			estimate = *c
+		}
+	}
+	s.total += count
+	return s.track(key, estimate)
+}
+
+func (s *CountMinSketch[K]) Estimate(key K) uint64 {
+	h1, h2 := hashes(s.hasher, key)
+	estimate := uint64(math.MaxUint64)
+	for row := uint32(0); row < s.depth; row++ {
+		if c := s.counters[row*s.width+position(h1, h2, row, s.width)]; c < estimate {
+			estimate = c
+		}
+	}
+	return estimate
+}
+
+// track updates key's place among the heavy hitters after its estimate
+// rose to estimate.
+func (s *CountMinSketch[K]) track(key K, estimate uint64) error {
+	if s.topK == 0 {
+		return nil
+	}
+	if _, ok := s.tracked[key]; !ok && len(s.tracked) == s.topK {
+		min, minKey, err := s.min()
+		if err != nil {
+			return err
+		}
+		if estimate <= min {
+			return nil
+		}
+		if _, err := s.heap.DeleteMin(); err != nil {
+			return err
+		}
+		delete(s.tracked, minKey)
+	}
+
+	s.tracked[key] = estimate
+	if err := s.heap.Insert(estimate, key); err != nil {
+		return err
+	}
+	if s.heap.Heap.GetSize() > int32(4*s.topK) {
+		return s.rebuild()
+	}
+	return nil
+}
+
+// min drops stale entries from the root of the heap and returns the
+// smallest tracked estimate and its key.
+func (s *CountMinSketch[K]) min() (uint64, K, error) {
+	for {
+		root, err := s.heap.FindMin()
+		if err != nil {
+			return 0, *new(K), err
+		}
+		if estimate, ok := s.tracked[root.GetValue()]; ok && estimate == root.GetKey() {
+			return root.GetKey(), root.GetValue(), nil
+		}
+		if _, err := s.heap.DeleteMin(); err != nil {
+			return 0, *new(K), err
+		}
+	}
+}
+
+// rebuild replaces the heap with one entry per tracked key, bounding the
+// stale entries to a few times topK.
+func (s *CountMinSketch[K]) rebuild() error {
+	s.heap = minheap.NewMinHeap[uint64, K]()
+	for key, estimate := range s.tracked {
+		if err := s.heap.Insert(estimate, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HeavyHitters returns the tracked keys, up to topK, from the highest
+// estimate down. A key that rose above the others only after they filled
+// the list is tracked from that point on, so the list is exact for keys
+// whose estimate stays above the others throughout.
+func (s *CountMinSketch[K]) HeavyHitters() []HeavyHitter[K] {
+	out := make([]HeavyHitter[K], 0, len(s.tracked))
+	for key, estimate := range s.tracked {
+		out = append(out, HeavyHitter[K]{Key: key, Count: estimate})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Count > out[j].Count
+	})
+	return out
+}
+
+// Merge adds the counts of other, which must have the same shape and
+// hasher, as if every add had been made to s. The heavy hitters of both
+// are re-estimated and the top ones kept.
+func (s *CountMinSketch[K]) Merge(other *CountMinSketch[K]) error {
+	if s.width != other.width || s.depth != other.depth {
+		return ErrIncompatible
+	}
+	for i, c := range other.counters {
+		s.counters[i] += c
+	}
+	s.total += other.total
+
+	candidates := make([]K, 0, len(s.tracked)+len(other.tracked))
+	for key := range s.tracked {
+		candidates = append(candidates, key)
+	}
+	for key := range other.tracked {
+		candidates = append(candidates, key)
+	}
+	s.tracked = make(map[K]uint64)
+	s.heap = minheap.NewMinHeap[uint64, K]()
+	for _, key := range candidates {
+		if _, ok := s.tracked[key]; ok {
+			continue
+		}
+		if err := s.track(key, s.Estimate(key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ErrorBound is the amount, epsilon*N, by which an estimate exceeds the
+// true count with probability at most delta.
+func (s *CountMinSketch[K]) ErrorBound() uint64 {
+	return uint64(math.Ceil(math.E / float64(s.width) * float64(s.total)))
+}
+
+func (s *CountMinSketch[K]) GetTotal() uint64 {
+	return s.total
+}
+
+func (s *CountMinSketch[K]) GetWidth() uint32 {
+	return s.width
+}
+
+func (s *CountMinSketch[K]) GetDepth() uint32 {
+	return s.depth
+}
@@ -0,0 +1,151 @@
+package probabilistic
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/stretchr/testify/assert"
+)
+
+// zipfStream returns n names drawn with a Zipf distribution from a pool of
+// distinct random names, and the true count of each.
+func zipfStream(seed int64, distinct, n int) ([]string, map[string]uint64) {
+	r := rand.New(rand.NewSource(seed))
+	randomdata.CustomRand(r)
+
+	pool := make([]string, 0, distinct)
+	seen := map[string]bool{}
+	for len(pool) < distinct {
+		name := fmt.Sprintf("%s-%d", randomdata.SillyName(), randomdata.Number(1000))
+		if !seen[name] {
+			seen[name] = true
+			pool = append(pool, name)
+		}
+	}
+
+	zipf := rand.NewZipf(r, 1.1, 1, uint64(distinct-1))
+	stream := make([]string, n)
+	counts := map[string]uint64{}
+	for i := range stream {
+		stream[i] = pool[zipf.Uint64()]
+		counts[stream[i]]++
+	}
+	return stream, counts
+}
+
+// topKeys returns the k keys with the highest counts.
+func topKeys(counts map[string]uint64, k int) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return counts[keys[i]] > counts[keys[j]]
+	})
+	return keys[:k]
+}
+
+func hitterKeys(hitters []HeavyHitter[string]) []string {
+	keys := make([]string, len(hitters))
+	for i, h := range hitters {
+		keys[i] = h.Key
+	}
+	return keys
+}
+
+func Test_CountMinSketch(t *testing.T) {
+	is := assert.New(t)
+
+	const (
+		epsilon = 0.001
+		delta   = 0.01
+		n       = 100000
+	)
+	stream, counts := zipfStream(1, 5000, n)
+
+	s, err := NewCountMinSketch[string](epsilon, delta, 10)
+	is.Nil(err)
+	is.EqualValues(2719, s.GetWidth())
+	is.EqualValues(5, s.GetDepth())
+	for _, key := range stream {
+		is.Nil(s.Add(key, 1))
+	}
+	is.EqualValues(n, s.GetTotal())
+	is.LessOrEqual(s.ErrorBound(), uint64(epsilon*n))
+
+	over := 0
+	for key, count := range counts {
+		estimate := s.Estimate(key)
+		is.GreaterOrEqual(estimate, count)
+		if estimate-count > s.ErrorBound() {
+			over++
+		}
+	}
+	is.LessOrEqual(float64(over)/float64(len(counts)), delta)
+
+	hitters := s.HeavyHitters()
+	is.Len(hitters, 10)
+	is.Equal(topKeys(counts, 10), hitterKeys(hitters))
+	for _, h := range hitters {
+		is.Equal(s.Estimate(h.Key), h.Count)
+	}
+}
+
+func Test_CountMinSketchWeighted(t *testing.T) {
+	is := assert.New(t)
+
+	s, _ := NewCountMinSketch[string](0.01, 0.01, 2)
+	is.Nil(s.Add("a", 5))
+	is.Nil(s.Add("b", 3))
+	is.Nil(s.Add("c", 1))
+	is.Nil(s.Add("c", 10))
+	is.Zero(s.Estimate("d"))
+
+	is.Equal([]HeavyHitter[string]{{"c", 11}, {"a", 5}}, s.HeavyHitters())
+}
+
+func Test_CountMinSketchMerge(t *testing.T) {
+	is := assert.New(t)
+
+	stream, counts := zipfStream(2, 2000, 40000)
+	whole, _ := NewCountMinSketch[string](0.005, 0.01, 5)
+	a, _ := NewCountMinSketch[string](0.005, 0.01, 5)
+	b, _ := NewCountMinSketch[string](0.005, 0.01, 5)
+	for i, key := range stream {
+		is.Nil(whole.Add(key, 1))
+		if i < len(stream)/2 {
+			is.Nil(a.Add(key, 1))
+		} else {
+			is.Nil(b.Add(key, 1))
+		}
+	}
+
+	is.Nil(a.Merge(b))
+	is.Equal(whole.counters, a.counters)
+	is.Equal(whole.GetTotal(), a.GetTotal())
+	is.Equal(topKeys(counts, 5), hitterKeys(a.HeavyHitters()))
+
+	c, _ := NewCountMinSketch[string](0.01, 0.01, 5)
+	is.ErrorIs(a.Merge(c), ErrIncompatible)
+}
+
+func Test_CountMinSketchConfig(t *testing.T) {
+	is := assert.New(t)
+
+	for _, tt := range []struct {
+		epsilon, delta float64
+		topK           int
+	}{{0, 0.1, 0}, {1, 0.1, 0}, {0.1, 0, 0}, {0.1, 1, 0}, {0.1, 0.1, -1}} {
+		_, err := NewCountMinSketch[int](tt.epsilon, tt.delta, tt.topK)
+		is.ErrorIs(err, ErrInvalidConfig)
+	}
+
+	// Without topK no heavy hitters are tracked.
+	s, err := NewCountMinSketch[int](0.1, 0.1, 0)
+	is.Nil(err)
+	is.Nil(s.Add(1, 1))
+	is.Empty(s.HeavyHitters())
+}
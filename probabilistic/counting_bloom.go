@@ -0,0 +1,135 @@
+package probabilistic
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+)
+
+// CountingBloomFilter is a Bloom filter with a counter in place of each bit,
+// so keys can be deleted. Counters saturate at 255 and are never
+// decremented again, trading a little accuracy for never producing false
+// negatives.
+type CountingBloomFilter[K comparable] struct {
+	counters []uint8
+	m        uint32
+	k        uint32
+	count    uint32
+	hasher   hashtables.Hasher[K]
+}
+
+var _ Filter[string] = new(CountingBloomFilter[string])
+
+// NewCountingBloomFilter sizes the filter like NewBloomFilter, with one
+// byte per position instead of one bit.
+func NewCountingBloomFilter[K comparable](n uint32, p float64) (*CountingBloomFilter[K], error) {
+	return NewCountingBloomFilterWithHasher[K](n, p, hashtables.FNVHasher[K]{})
+}
+
+func NewCountingBloomFilterWithHasher[K comparable](n uint32, p float64, hasher hashtables.Hasher[K]) (*CountingBloomFilter[K], error) {
+	m, k, err := OptimalBloomSize(n, p)
+	if err != nil {
+		return nil, err
+	}
+	return &CountingBloomFilter[K]{
+		counters: make([]uint8, m),
+		m:        m,
+		k:        k,
+		hasher:   hasher,
+	}, nil
+}
+
+func (f *CountingBloomFilter[K]) Insert(key K) error {
+	h1, h2 := hashes(f.hasher, key)
+	for i := uint32(0); i < f.k; i++ {
+		p := position(h1, h2, i, f.m)
+		if f.counters[p] < math.MaxUint8 {
+			f.counters[p]++
+		}
+	}
+	f.count++
+	return nil
+}
+
+func (f *CountingBloomFilter[K]) Contains(key K) bool {
+	h1, h2 := hashes(f.hasher, key)
+	for i := uint32(0); i < f.k; i++ {
+		if f.counters[position(h1, h2, i, f.m)] == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Delete removes one insert of key. It returns ErrNotFound for keys that
+// are definitely absent; deleting a false positive, or a key more times
+// than it was inserted, corrupts the filter for other keys.
+func (f *CountingBloomFilter[K]) Delete(key K) error {
+	if !f.Contains(key) {
+		return fmt.Errorf("key %v %w", key, ErrNotFound)
+	}
+	h1, h2 := hashes(f.hasher, key)
+	for i := uint32(0); i < f.k; i++ {
+		p := position(h1, h2, i, f.m)
+		if f.counters[p] < math.MaxUint8 {
+			f.counters[p]--
+		}
+	}
+	f.count--
+	return nil
+}
+
+// Union adds every insert of other, which must have the same shape and
+// hasher.
+func (f *CountingBloomFilter[K]) Union(other *CountingBloomFilter[K]) error {
+	if f.m != other.m || f.k != other.k {
+		return ErrIncompatible
+	}
+	for i, c := range other.counters {
+		if sum := uint16(f.counters[i]) + uint16(c); sum < math.MaxUint8 {
+			f.counters[i] = uint8(sum)
+		} else {
+			f.counters[i] = math.MaxUint8
+		}
+	}
+	f.count += other.count
+	return nil
+}
+
+func (f *CountingBloomFilter[K]) MarshalBinary() ([]byte, error) {
+	return append(header('C', f.m, f.k, f.count), f.counters...), nil
+}
+
+// UnmarshalBinary replaces the filter's contents and shape. The filter
+// keeps its own hasher, which must match the one that built the data.
+func (f *CountingBloomFilter[K]) UnmarshalBinary(data []byte) error {
+	fields, payload, err := readHeader(data, 'C', 3)
+	if err != nil {
+		return err
+	}
+	m, k, count := fields[0], fields[1], fields[2]
+	if m == 0 || k == 0 || len(payload) != int(m) {
+		return ErrInvalidData
+	}
+
+	if f.hasher == nil {
+		f.hasher = hashtables.FNVHasher[K]{}
+	}
+	f.m, f.k, f.count = m, k, count
+	f.counters = append([]uint8(nil), payload...)
+	return nil
+}
+
+func (f *CountingBloomFilter[K]) GetBits() uint32 {
+	return f.m
+}
+
+func (f *CountingBloomFilter[K]) GetHashes() uint32 {
+	return f.k
+}
+
+// GetCount returns the number of inserts less deletes.
+func (f *CountingBloomFilter[K]) GetCount() uint32 {
+	return f.count
+}
@@ -0,0 +1,92 @@
+package probabilistic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CountingBloomFilter(t *testing.T) {
+	is := assert.New(t)
+
+	const n = 5000
+	f, err := NewCountingBloomFilter[int](n, 0.01)
+	is.Nil(err)
+	for key := 1; key <= n; key++ {
+		is.Nil(f.Insert(key))
+	}
+	is.Less(falsePositiveRate(f.Contains, n, 50000), 0.015)
+
+	// Deleting the odd keys leaves the even ones.
+	for key := 1; key <= n; key += 2 {
+		is.Nil(f.Delete(key))
+	}
+	is.EqualValues(n/2, f.GetCount())
+	for key := 2; key <= n; key += 2 {
+		is.True(f.Contains(key))
+	}
+	deleted := falsePositiveRate(func(key int) bool { return f.Contains(2*key - n - 1) }, n/2, n/2)
+	is.Less(deleted, 0.01)
+
+	is.ErrorIs(f.Delete(-1), ErrNotFound)
+}
+
+func Test_CountingBloomFilterDuplicates(t *testing.T) {
+	is := assert.New(t)
+
+	f, _ := NewCountingBloomFilter[string](10, 0.01)
+	is.Nil(f.Insert("a"))
+	is.Nil(f.Insert("a"))
+	is.Nil(f.Delete("a"))
+	is.True(f.Contains("a"))
+	is.Nil(f.Delete("a"))
+	is.False(f.Contains("a"))
+}
+
+func Test_CountingBloomFilterSaturation(t *testing.T) {
+	is := assert.New(t)
+
+	f, _ := NewCountingBloomFilter[string](10, 0.01)
+	for i := 0; i < 300; i++ {
+		is.Nil(f.Insert("a"))
+	}
+	for i := 0; i < 300; i++ {
+		is.Nil(f.Delete("a"))
+	}
+	// Saturated counters never drop back to zero.
+	is.True(f.Contains("a"))
+}
+
+func Test_CountingBloomFilterUnionAndSerialisation(t *testing.T) {
+	is := assert.New(t)
+
+	a, _ := NewCountingBloomFilter[string](100, 0.01)
+	b, _ := NewCountingBloomFilter[string](100, 0.01)
+	is.Nil(a.Insert("a"))
+	is.Nil(b.Insert("a"))
+	is.Nil(b.Insert("b"))
+	is.Nil(a.Union(b))
+	is.EqualValues(3, a.GetCount())
+
+	// "a" was inserted twice across the two filters.
+	is.Nil(a.Delete("a"))
+	is.True(a.Contains("a"))
+
+	c, _ := NewCountingBloomFilter[string](100, 0.1)
+	is.ErrorIs(a.Union(c), ErrIncompatible)
+
+	data, err := a.MarshalBinary()
+	is.Nil(err)
+	var d CountingBloomFilter[string]
+	is.Nil(d.UnmarshalBinary(data))
+	is.True(d.Contains("a"))
+	is.True(d.Contains("b"))
+	is.Equal(a.GetBits(), d.GetBits())
+	is.Equal(a.GetHashes(), d.GetHashes())
+	is.EqualValues(2, d.GetCount())
+
+	is.ErrorIs(d.UnmarshalBinary(data[:5]), ErrInvalidData)
+	bloom, _ := NewBloomFilter[string](100, 0.01)
+	data, _ = bloom.MarshalBinary()
+	is.ErrorIs(d.UnmarshalBinary(data), ErrInvalidData)
+}
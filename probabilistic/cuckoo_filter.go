@@ -0,0 +1,241 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+)
+
+const (
+	// BUCKET_SIZE is the number of fingerprints a cuckoo filter bucket
+	// holds.
+	BUCKET_SIZE = 4
+	// MAX_KICKS bounds the evictions of one insert before the filter is
+	// considered full.
+	MAX_KICKS = 500
+)
+
+// CuckooFilter stores a 16-bit fingerprint of each key in one of two
+// buckets, like a cuckoo hash table, so unlike a Bloom filter it supports
+// Delete without counters. The second bucket is derived from the first and
+// the fingerprint alone, which lets fingerprints move without their keys.
+// A missing key matches one of the 2*BUCKET_SIZE fingerprints it is
+// compared with with probability at most 2*BUCKET_SIZE/2^16, about 0.012%.
+//
+// When an insert runs out of evictions, the fingerprint left over is kept
+// aside as the victim and further inserts return ErrFull until a delete
+// makes room for it.
+type CuckooFilter[K comparable] struct {
+	buckets []uint16
+	mask    uint32
+	count   uint32
+	victim  victim
+	rng     uint32
+	hasher  hashtables.Hasher[K]
+}
+
+type victim struct {
+	index uint32
+	fp    uint16
+}
+
+var _ Filter[string] = new(CuckooFilter[string])
+
+// NewCuckooFilter sizes the filter to hold n keys at 95% load.
+func NewCuckooFilter[K comparable](n uint32) (*CuckooFilter[K], error) {
+	return NewCuckooFilterWithHasher[K](n, hashtables.FNVHasher[K]{})
+}
+
+func NewCuckooFilterWithHasher[K comparable](n uint32, hasher hashtables.Hasher[K]) (*CuckooFilter[K], error) {
+	if n == 0 {
+		return nil, ErrInvalidConfig
+	}
+	buckets := uint32(1)
+	for float64(buckets)*BUCKET_SIZE*0.95 < float64(n) {
+		buckets <<= 1
+	}
+	return newCuckooFilter(buckets, hasher), nil
+}
+
+func newCuckooFilter[K comparable](buckets uint32, hasher hashtables.Hasher[K]) *CuckooFilter[K] {
+	return &CuckooFilter[K]{
+		buckets: make([]uint16, buckets*BUCKET_SIZE),
+		mask:    buckets - 1,
+		rng:     2463534242,
+		hasher:  hasher,
+	}
+}
+
+// fingerprint returns key's first bucket and its non-zero fingerprint.
+func (f *CuckooFilter[K]) fingerprint(key K) (uint32, uint16) {
+	probe := f.hasher.Probe(key)
+	fp := uint16(probe>>16) ^ uint16(probe)
+	if fp == 0 {
+		fp = 1
+	}
+	return f.hasher.Hash(key) & f.mask, fp
+}
+
+// alt is the other bucket of a fingerprint in bucket i. It is its own
+// inverse, since the bucket count is a power of two.
+func (f *CuckooFilter[K]) alt(i uint32, fp uint16) uint32 {
+	return (i ^ hashtables.Mix(uint32(fp))) & f.mask
+}
+
+func (f *CuckooFilter[K]) Insert(key K) error {
+	i, fp := f.fingerprint(key)
+	return f.insert(i, fp)
+}
+
+func (f *CuckooFilter[K]) insert(i uint32, fp uint16) error {
+	if f.victim.fp != 0 {
+		return ErrFull
+	}
+	f.count++
+	if f.add(i, fp) || f.add(f.alt(i, fp), fp) {
+		return nil
+	}
+
+	if f.next()%2 == 1 {
+		i = f.alt(i, fp)
+	}
+	for kicks := 0; kicks < MAX_KICKS; kicks++ {
+		slot := i*BUCKET_SIZE + f.next()%BUCKET_SIZE
+		f.buckets[slot], fp = fp, f.buckets[slot]
+		i = f.alt(i, fp)
+		if f.add(i, fp) {
+			return nil
+		}
+	}
+	f.victim = victim{index: i, fp: fp}
+	return nil
+}
+
+// add puts fp in a free slot of bucket i.
+func (f *CuckooFilter[K]) add(i uint32, fp uint16) bool {
+	for slot := i * BUCKET_SIZE; slot < (i+1)*BUCKET_SIZE; slot++ {
+		if f.buckets[slot] == 0 {
+			f.buckets[slot] = fp
+			return true
+		}
+	}
+	return false
+}
+
+func (f *CuckooFilter[K]) Contains(key K) bool {
+	i, fp := f.fingerprint(key)
+	return f.find(i, fp) >= 0 || f.find(f.alt(i, fp), fp) >= 0 ||
+		(f.victim.fp == fp && (f.victim.index == i || f.victim.index == f.alt(i, fp)))
+}
+
+// find returns the slot holding fp in bucket i, or -1.
+func (f *CuckooFilter[K]) find(i uint32, fp uint16) int {
+	for slot := i * BUCKET_SIZE; slot < (i+1)*BUCKET_SIZE; slot++ {
+		if f.buckets[slot] == fp {
+			return int(slot)
+		}
+	}
+	return -1
+}
+
+// Delete removes one insert of key. It returns ErrNotFound for keys that
+// are definitely absent; deleting a key that was never inserted may remove
+// another key's fingerprint.
+func (f *CuckooFilter[K]) Delete(key K) error {
+	i, fp := f.fingerprint(key)
+	switch {
+	case f.victim.fp == fp && (f.victim.index == i || f.victim.index == f.alt(i, fp)):
+		f.victim = victim{}
+	case f.find(i, fp) >= 0:
+		f.buckets[f.find(i, fp)] = 0
+	case f.find(f.alt(i, fp), fp) >= 0:
+		f.buckets[f.find(f.alt(i, fp), fp)] = 0
+	default:
+		return fmt.Errorf("key %v %w", key, ErrNotFound)
+	}
+	f.count--
+
+	if v := f.victim; v.fp != 0 && (f.add(v.index, v.fp) || f.add(f.alt(v.index, v.fp), v.fp)) {
+		f.victim = victim{}
+	}
+	return nil
+}
+
+// Union inserts every fingerprint of other, which must have the same number
+// of buckets and the same hasher. It returns ErrFull if they do not all
+// fit, leaving the filter with those that did.
+func (f *CuckooFilter[K]) Union(other *CuckooFilter[K]) error {
+	if f.mask != other.mask {
+		return ErrIncompatible
+	}
+	for slot, fp := range other.buckets {
+		if fp == 0 {
+			continue
+		}
+		if err := f.insert(uint32(slot)/BUCKET_SIZE, fp); err != nil {
+			return err
+		}
+	}
+	if other.victim.fp != 0 {
+		return f.insert(other.victim.index, other.victim.fp)
+	}
+	return nil
+}
+
+// LoadFactor is the fraction of fingerprint slots in use.
+func (f *CuckooFilter[K]) LoadFactor() float64 {
+	return float64(f.count) / float64(len(f.buckets))
+}
+
+func (f *CuckooFilter[K]) GetCount() uint32 {
+	return f.count
+}
+
+func (f *CuckooFilter[K]) GetBuckets() uint32 {
+	return f.mask + 1
+}
+
+func (f *CuckooFilter[K]) MarshalBinary() ([]byte, error) {
+	out := header('F', f.mask+1, f.count, f.victim.index, uint32(f.victim.fp))
+	buf := make([]byte, 2*len(f.buckets))
+	for i, fp := range f.buckets {
+		binary.LittleEndian.PutUint16(buf[2*i:], fp)
+	}
+	return append(out, buf...), nil
+}
+
+// UnmarshalBinary replaces the filter's contents and shape. The filter
+// keeps its own hasher, which must match the one that built the data.
+func (f *CuckooFilter[K]) UnmarshalBinary(data []byte) error {
+	fields, payload, err := readHeader(data, 'F', 4)
+	if err != nil {
+		return err
+	}
+	buckets, count := fields[0], fields[1]
+	v := victim{index: fields[2], fp: uint16(fields[3])}
+	if buckets == 0 || buckets&(buckets-1) != 0 || fields[3] > math.MaxUint16 ||
+		v.index >= buckets || len(payload) != 2*BUCKET_SIZE*int(buckets) {
+		return ErrInvalidData
+	}
+
+	if f.hasher == nil {
+		f.hasher = hashtables.FNVHasher[K]{}
+	}
+	*f = *newCuckooFilter(buckets, f.hasher)
+	for i := range f.buckets {
+		f.buckets[i] = binary.LittleEndian.Uint16(payload[2*i:])
+	}
+	f.count = count
+	f.victim = v
+	return nil
+}
+
+// next is an xorshift generator that picks eviction victims.
+func (f *CuckooFilter[K]) next() uint32 {
+	f.rng ^= f.rng << 13
+	f.rng ^= f.rng >> 17
+	f.rng ^= f.rng << 5
+	return f.rng
+}
@@ -0,0 +1,120 @@
+package probabilistic
+
+import (
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CuckooFilter(t *testing.T) {
+	is := assert.New(t)
+
+	const n = 10000
+	f, err := NewCuckooFilter[int](n)
+	is.Nil(err)
+	is.EqualValues(4096, f.GetBuckets())
+
+	for key := 1; key <= n; key++ {
+		is.Nil(f.Insert(key))
+	}
+	for key := 1; key <= n; key++ {
+		is.True(f.Contains(key))
+	}
+	is.Less(falsePositiveRate(f.Contains, n, 100000), 2.0*BUCKET_SIZE/(1<<16))
+
+	for key := 1; key <= n; key += 2 {
+		is.Nil(f.Delete(key))
+	}
+	is.EqualValues(n/2, f.GetCount())
+	for key := 2; key <= n; key += 2 {
+		is.True(f.Contains(key))
+	}
+	is.ErrorIs(f.Delete(-1), ErrNotFound)
+
+	_, err = NewCuckooFilter[int](0)
+	is.ErrorIs(err, ErrInvalidConfig)
+}
+
+func Test_CuckooFilterFull(t *testing.T) {
+	is := assert.New(t)
+
+	f, _ := NewCuckooFilter[int](64)
+	capacity := len(f.buckets)
+
+	inserted := 0
+	for key := 1; ; key++ {
+		if err := f.Insert(key); err != nil {
+			is.ErrorIs(err, ErrFull)
+			break
+		}
+		inserted++
+	}
+	is.Greater(f.LoadFactor(), 0.9)
+	is.LessOrEqual(inserted, capacity+1)
+
+	// Every insert that succeeded, the victim included, is still found.
+	for key := 1; key <= inserted; key++ {
+		is.True(f.Contains(key))
+	}
+
+	// Deletes make room for the victim and then for new keys.
+	for key := 1; f.victim.fp != 0; key++ {
+		is.Nil(f.Delete(key))
+	}
+	is.Nil(f.Insert(-1))
+	is.True(f.Contains(-1))
+}
+
+func Test_CuckooFilterUnion(t *testing.T) {
+	is := assert.New(t)
+
+	a, _ := NewCuckooFilter[int](1000)
+	b, _ := NewCuckooFilter[int](1000)
+	for key := 1; key <= 400; key++ {
+		is.Nil(a.Insert(key))
+		is.Nil(b.Insert(key + 400))
+	}
+
+	is.Nil(a.Union(b))
+	is.EqualValues(800, a.GetCount())
+	for key := 1; key <= 800; key++ {
+		is.True(a.Contains(key))
+	}
+
+	c, _ := NewCuckooFilter[int](10)
+	is.ErrorIs(a.Union(c), ErrIncompatible)
+
+	// Union stops once the filter fills up.
+	full, _ := NewCuckooFilter[int](8)
+	small, _ := NewCuckooFilter[int](8)
+	for key := 1; key <= 12; key++ {
+		is.Nil(full.Insert(key))
+		is.Nil(small.Insert(-key))
+	}
+	is.ErrorIs(full.Union(small), ErrFull)
+}
+
+func Test_CuckooFilterSerialisation(t *testing.T) {
+	is := assert.New(t)
+
+	hasher := hashtables.NewXXHasher[int](9)
+	f, _ := NewCuckooFilterWithHasher[int](16, hasher)
+	for key := 1; f.Insert(key) == nil; key++ {
+	}
+
+	data, err := f.MarshalBinary()
+	is.Nil(err)
+	g, _ := NewCuckooFilterWithHasher[int](1, hasher)
+	is.Nil(g.UnmarshalBinary(data))
+	is.Equal(f.buckets, g.buckets)
+	is.Equal(f.victim, g.victim)
+	is.Equal(f.GetCount(), g.GetCount())
+	for key := 1; key <= 100; key++ {
+		is.Equal(f.Contains(key), g.Contains(key))
+	}
+
+	is.ErrorIs(g.UnmarshalBinary(data[:len(data)-2]), ErrInvalidData)
+	data[2] = 3 // not a power of two
+	is.ErrorIs(g.UnmarshalBinary(data), ErrInvalidData)
+}
@@ -0,0 +1,32 @@
+package probabilistic_test
+
+import (
+	"fmt"
+
+	doublehashing "github.com/OladapoAjala/datastructures/hashtables/double_hashing"
+	"github.com/OladapoAjala/datastructures/probabilistic"
+)
+
+// A Bloom filter in front of a table skips the lookup for keys that are
+// definitely absent.
+func Example() {
+	table := doublehashing.NewHashTable[string, int](0)
+	filter, _ := probabilistic.NewBloomFilter[string](1000, 0.01)
+
+	for i, key := range []string{"apple", "banana", "cherry"} {
+		_ = table.Insert(key, i)
+		_ = filter.Insert(key)
+	}
+
+	for _, key := range []string{"banana", "durian"} {
+		if !filter.Contains(key) {
+			fmt.Println(key, "absent")
+			continue
+		}
+		value, err := table.Find(key)
+		fmt.Println(key, value, err)
+	}
+	// Output:
+	// banana 1 <nil>
+	// durian absent
+}
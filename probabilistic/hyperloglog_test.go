@@ -0,0 +1,105 @@
+package probabilistic
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/stretchr/testify/assert"
+)
+
+// ipStream returns n addresses drawn from a pool of about distinct random
+// IPv4 addresses, and the number of distinct addresses drawn.
+func ipStream(seed int64, distinct, n int) ([]string, int) {
+	randomdata.CustomRand(rand.New(rand.NewSource(seed)))
+	pool := make([]string, distinct)
+	for i := range pool {
+		pool[i] = randomdata.IpV4Address()
+	}
+
+	stream := make([]string, n)
+	seen := map[string]bool{}
+	for i := range stream {
+		stream[i] = pool[randomdata.Number(distinct)]
+		seen[stream[i]] = true
+	}
+	return stream, len(seen)
+}
+
+func Test_HyperLogLog(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		p        uint8
+		distinct int
+		n        int
+	}{
+		{p: 14, distinct: 100, n: 1000},
+		{p: 14, distinct: 5000, n: 20000},
+		{p: 14, distinct: 100000, n: 200000},
+		{p: 10, distinct: 50000, n: 100000},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("p=%d/distinct=%d", tt.p, tt.distinct), func(t *testing.T) {
+			stream, distinct := ipStream(int64(i), tt.distinct, tt.n)
+			h, err := NewHyperLogLog[string](tt.p)
+			is.Nil(err)
+			for _, key := range stream {
+				h.Insert(key)
+			}
+
+			relative := math.Abs(float64(h.Count())-float64(distinct)) / float64(distinct)
+			is.Less(relative, 3*h.StandardError(), "estimate %d for %d", h.Count(), distinct)
+		})
+	}
+}
+
+func Test_HyperLogLogEmpty(t *testing.T) {
+	is := assert.New(t)
+
+	h, _ := NewHyperLogLog[int](MIN_PRECISION)
+	is.Zero(h.Count())
+	h.Insert(1)
+	h.Insert(1)
+	is.EqualValues(1, h.Count())
+	is.InDelta(0.26, h.StandardError(), 1e-9)
+}
+
+func Test_HyperLogLogMerge(t *testing.T) {
+	is := assert.New(t)
+
+	stream, _ := ipStream(7, 30000, 60000)
+	whole, _ := NewHyperLogLog[string](12)
+	a, _ := NewHyperLogLog[string](12)
+	b, _ := NewHyperLogLog[string](12)
+	for i, key := range stream {
+		whole.Insert(key)
+		if i%2 == 0 {
+			a.Insert(key)
+		} else {
+			b.Insert(key)
+		}
+	}
+
+	is.Nil(a.Merge(b))
+	is.Equal(whole.registers, a.registers)
+	is.Equal(whole.Count(), a.Count())
+
+	c, _ := NewHyperLogLog[string](13)
+	is.ErrorIs(a.Merge(c), ErrIncompatible)
+}
+
+func Test_HyperLogLogConfig(t *testing.T) {
+	is := assert.New(t)
+
+	for _, p := range []uint8{0, MIN_PRECISION - 1, MAX_PRECISION + 1} {
+		_, err := NewHyperLogLog[int](p)
+		is.ErrorIs(err, ErrInvalidConfig)
+	}
+	h, err := NewHyperLogLog[int](MAX_PRECISION)
+	is.Nil(err)
+	is.EqualValues(MAX_PRECISION, h.GetPrecision())
+}
@@ -0,0 +1,72 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+)
+
+var (
+	ErrInvalidConfig = errors.New("invalid filter config")
+	// ErrIncompatible is returned when combining filters of different
+	// shapes.
+	ErrIncompatible = errors.New("filters are incompatible")
+	ErrInvalidData  = errors.New("invalid serialised filter")
+	ErrFull         = errors.New("filter is full")
+	ErrNotFound     = errors.New("not found in filter")
+)
+
+// Filter is a set that may report false positives but never false
+// negatives.
+//
+// The filters place keys with a hashtables.Hasher. A serialised filter can
+// only be read back by a filter using the same hasher, so filters that are
+// stored or sent elsewhere should use a deterministic one, such as the
+// default hashtables.FNVHasher or a hashtables.SipHasher with fixed keys,
+// and not a randomly seeded hashtables.MapHasher. The same goes for Union.
+type Filter[K comparable] interface {
+	Insert(K) error
+	Contains(K) bool
+	MarshalBinary() ([]byte, error)
+	UnmarshalBinary([]byte) error
+}
+
+const version = 1
+
+// hashes returns the pair of hashes from which double hashing derives a
+// key's positions. The second is odd so that it never degenerates to a
+// single position.
+func hashes[K comparable](hasher hashtables.Hasher[K], key K) (uint64, uint64) {
+	return uint64(hasher.Hash(key)), uint64(hasher.Probe(key)) | 1
+}
+
+// position is the i-th of a key's positions among m.
+func position(h1, h2 uint64, i, m uint32) uint32 {
+	return uint32((h1 + uint64(i)*h2) % uint64(m))
+}
+
+// header starts every serialised filter: a magic byte naming the filter
+// type, the format version and the filter's shape.
+func header(magic byte, fields ...uint32) []byte {
+	out := make([]byte, 2+4*len(fields))
+	out[0], out[1] = magic, version
+	for i, f := range fields {
+		binary.LittleEndian.PutUint32(out[2+4*i:], f)
+	}
+	return out
+}
+
+// readHeader checks the magic byte and version and returns the shape fields
+// and the remaining payload.
+func readHeader(data []byte, magic byte, fields int) ([]uint32, []byte, error) {
+	size := 2 + 4*fields
+	if len(data) < size || data[0] != magic || data[1] != version {
+		return nil, nil, ErrInvalidData
+	}
+	out := make([]uint32, fields)
+	for i := range out {
+		out[i] = binary.LittleEndian.Uint32(data[2+4*i:])
+	}
+	return out, data[size:], nil
+}
@@ -0,0 +1,22 @@
+package delayqueue
+
+import "time"
+
+// Clock is the time source of a queue. Tests inject a manual clock to make
+// deadlines deterministic.
+type Clock interface {
+	Now() time.Time
+	After(time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+var RealClock Clock = realClock{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
@@ -0,0 +1,251 @@
+package delayqueue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/OladapoAjala/datastructures/heap/minheap"
+)
+
+var (
+	ErrNotReady     = errors.New("no item is due")
+	ErrNotScheduled = errors.New("item is not scheduled")
+	ErrForeignItem  = errors.New("item belongs to another queue")
+)
+
+// Item is the handle returned by Schedule. It is used to cancel or
+// reschedule the item.
+type Item[V any] struct {
+	Value    V
+	deadline time.Time
+	owner    any
+	seq      uint64
+	gen      uint64
+	queued   bool
+	bucket   map[*Item[V]]struct{}
+}
+
+func (it *Item[V]) GetDeadline() time.Time {
+	return it.deadline
+}
+
+// entry is what the queue actually stores. Cancelling or rescheduling bumps
+// the item's generation, which turns older entries stale without having to
+// find them.
+type entry[V any] struct {
+	item *Item[V]
+	gen  uint64
+}
+
+func (e *entry[V]) isStale() bool {
+	return !e.item.queued || e.gen != e.item.gen
+}
+
+type DelayQueuer[V any] interface {
+	Schedule(V, time.Time) *Item[V]
+	Cancel(*Item[V]) error
+	Reschedule(*Item[V], time.Time) error
+	Poll() (V, error)
+	Take(context.Context) (V, error)
+	GetSize() int32
+}
+
+// DelayQueue hands items out only once their deadline has passed, earliest
+// deadline first. It is safe for concurrent use.
+type DelayQueue[V any] struct {
+	mu    sync.Mutex
+	clock Clock
+	heap  *minheap.MinHeap[int64, *entry[V]]
+	size  int32
+	seq   uint64
+	wake  chan struct{}
+}
+
+var _ DelayQueuer[string] = new(DelayQueue[string])
+
+func NewDelayQueue[V any](clock Clock) *DelayQueue[V] {
+	if clock == nil {
+		clock = RealClock
+	}
+	return &DelayQueue[V]{
+		clock: clock,
+		heap:  minheap.NewStableMinHeap[int64, *entry[V]](),
+	}
+}
+
+func (q *DelayQueue[V]) Schedule(value V, deadline time.Time) *Item[V] {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	item := &Item[V]{Value: value, owner: q, seq: q.seq}
+	q.seq++
+	q.push(item, deadline)
+	return item
+}
+
+func (q *DelayQueue[V]) Cancel(item *Item[V]) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if item.owner != q {
+		return ErrForeignItem
+	}
+	if !item.queued {
+		return ErrNotScheduled
+	}
+	item.queued = false
+	item.gen++
+	q.size--
+	return q.compact()
+}
+
+// Reschedule moves the item to a new deadline, re-adding it if it has
+// already been handed out or cancelled.
+func (q *DelayQueue[V]) Reschedule(item *Item[V], deadline time.Time) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if item.owner != q {
+		return ErrForeignItem
+	}
+	if item.queued {
+		q.size--
+	}
+	item.gen++
+	q.push(item, deadline)
+	return q.compact()
+}
+
+// Poll returns the earliest item whose deadline has passed, or ErrNotReady.
+func (q *DelayQueue[V]) Poll() (V, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.poll(q.clock.Now())
+}
+
+// Take blocks until an item is due or ctx is done.
+func (q *DelayQueue[V]) Take(ctx context.Context) (V, error) {
+	for {
+		q.mu.Lock()
+		now := q.clock.Now()
+		value, err := q.poll(now)
+		if err == nil {
+			q.mu.Unlock()
+			return value, nil
+		}
+
+		var timer <-chan time.Time
+		if next, err := q.heap.FindMin(); err == nil {
+			timer = q.clock.After(time.Duration(next.GetKey() - now.UnixNano()))
+		}
+		if q.wake == nil {
+			q.wake = make(chan struct{})
+		}
+		wake := q.wake
+		q.mu.Unlock()
+
+		select {
+		case <-timer:
+		case <-wake:
+		case <-ctx.Done():
+			return *new(V), ctx.Err()
+		}
+	}
+}
+
+// NextDeadline returns the deadline of the earliest pending item.
+func (q *DelayQueue[V]) NextDeadline() (time.Time, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if err := q.dropStale(); err != nil {
+		return time.Time{}, err
+	}
+	next, err := q.heap.FindMin()
+	if err != nil {
+		return time.Time{}, ErrNotScheduled
+	}
+	return next.GetValue().item.deadline, nil
+}
+
+func (q *DelayQueue[V]) GetSize() int32 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.size
+}
+
+func (q *DelayQueue[V]) push(item *Item[V], deadline time.Time) {
+	item.deadline = deadline
+	item.queued = true
+	q.size++
+	// Insert only fails if the backing array cannot grow.
+	_ = q.heap.Insert(deadline.UnixNano(), &entry[V]{item: item, gen: item.gen})
+	if q.wake != nil {
+		close(q.wake)
+		q.wake = nil
+	}
+}
+
+func (q *DelayQueue[V]) poll(now time.Time) (V, error) {
+	if err := q.dropStale(); err != nil {
+		return *new(V), err
+	}
+	next, err := q.heap.FindMin()
+	if err != nil || next.GetKey() > now.UnixNano() {
+		return *new(V), ErrNotReady
+	}
+
+	min, err := q.heap.DeleteMin()
+	if err != nil {
+		return *new(V), err
+	}
+	item := min.GetValue().item
+	item.queued = false
+	q.size--
+	return item.Value, nil
+}
+
+// dropStale pops cancelled or rescheduled entries off the top of the heap.
+func (q *DelayQueue[V]) dropStale() error {
+	for !q.heap.IsEmpty() {
+		min, err := q.heap.FindMin()
+		if err != nil {
+			return err
+		}
+		if !min.GetValue().isStale() {
+			return nil
+		}
+		_, err = q.heap.DeleteMin()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compact rebuilds the heap once stale entries outnumber live ones, so heavy
+// cancel/reschedule traffic does not grow it without bound.
+func (q *DelayQueue[V]) compact() error {
+	if q.heap.Heap.GetSize() <= 2*q.size+64 {
+		return nil
+	}
+
+	heap := minheap.NewStableMinHeap[int64, *entry[V]]()
+	for !q.heap.IsEmpty() {
+		min, err := q.heap.DeleteMin()
+		if err != nil {
+			return err
+		}
+		if min.GetValue().isStale() {
+			continue
+		}
+		err = heap.Insert(min.GetKey(), min.GetValue())
+		if err != nil {
+			return err
+		}
+	}
+	q.heap = heap
+	return nil
+}
@@ -0,0 +1,222 @@
+package delayqueue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type manualClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []waiter
+}
+
+type waiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+func newManualClock() *manualClock {
+	return &manualClock{now: time.Unix(1_000_000, 0)}
+}
+
+func (c *manualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *manualClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+	c.waiters = append(c.waiters, waiter{at: c.now.Add(d), ch: ch})
+	return ch
+}
+
+func (c *manualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	pending := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.at.After(c.now) {
+			pending = append(pending, w)
+			continue
+		}
+		w.ch <- c.now
+	}
+	c.waiters = pending
+}
+
+func Test_DelayQueuePoll(t *testing.T) {
+	is := assert.New(t)
+	clock := newManualClock()
+	q := NewDelayQueue[string](clock)
+
+	tests := []struct {
+		name  string
+		setup func()
+		want  func(string, error)
+	}{
+		{
+			name: "poll empty queue",
+			want: func(value string, err error) {
+				is.ErrorIs(err, ErrNotReady)
+				is.Empty(value)
+			},
+		},
+		{
+			name: "poll before deadline",
+			setup: func() {
+				q.Schedule("b", clock.Now().Add(2*time.Second))
+				q.Schedule("a", clock.Now().Add(time.Second))
+			},
+			want: func(value string, err error) {
+				is.ErrorIs(err, ErrNotReady)
+				is.EqualValues(2, q.GetSize())
+			},
+		},
+		{
+			name: "poll earliest deadline once it passes",
+			setup: func() {
+				clock.Advance(time.Second)
+			},
+			want: func(value string, err error) {
+				is.Nil(err)
+				is.Equal("a", value)
+				is.EqualValues(1, q.GetSize())
+			},
+		},
+		{
+			name: "poll is not ready again until the next deadline",
+			want: func(value string, err error) {
+				is.ErrorIs(err, ErrNotReady)
+			},
+		},
+		{
+			name: "poll equal deadlines in insertion order",
+			setup: func() {
+				q.Schedule("c", clock.Now().Add(time.Second))
+				q.Schedule("d", clock.Now().Add(time.Second))
+				clock.Advance(time.Second)
+				value, err := q.Poll()
+				is.Nil(err)
+				is.Equal("b", value)
+			},
+			want: func(value string, err error) {
+				is.Nil(err)
+				is.Equal("c", value)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			value, err := q.Poll()
+			tt.want(value, err)
+		})
+	}
+}
+
+func testCancelAndReschedule(t *testing.T, clock *manualClock, q DelayQueuer[string]) {
+	is := assert.New(t)
+
+	a := q.Schedule("a", clock.Now().Add(time.Second))
+	b := q.Schedule("b", clock.Now().Add(2*time.Second))
+	c := q.Schedule("c", clock.Now().Add(3*time.Second))
+
+	is.Nil(q.Cancel(a))
+	is.ErrorIs(q.Cancel(a), ErrNotScheduled)
+	is.Nil(q.Reschedule(c, clock.Now().Add(500*time.Millisecond)))
+	is.EqualValues(2, q.GetSize())
+
+	clock.Advance(time.Second)
+	value, err := q.Poll()
+	is.Nil(err)
+	is.Equal("c", value)
+	_, err = q.Poll()
+	is.ErrorIs(err, ErrNotReady)
+
+	is.Nil(q.Reschedule(b, clock.Now().Add(time.Hour)))
+	clock.Advance(time.Minute)
+	_, err = q.Poll()
+	is.ErrorIs(err, ErrNotReady)
+
+	is.Nil(q.Reschedule(a, clock.Now()))
+	value, err = q.Poll()
+	is.Nil(err)
+	is.Equal("a", value)
+
+	clock.Advance(time.Hour)
+	value, err = q.Poll()
+	is.Nil(err)
+	is.Equal("b", value)
+	is.EqualValues(0, q.GetSize())
+
+	other := NewDelayQueue[string](clock)
+	is.ErrorIs(other.Cancel(b), ErrForeignItem)
+}
+
+func Test_DelayQueueCancelAndReschedule(t *testing.T) {
+	clock := newManualClock()
+	testCancelAndReschedule(t, clock, NewDelayQueue[string](clock))
+}
+
+func Test_DelayQueueCompaction(t *testing.T) {
+	is := assert.New(t)
+	clock := newManualClock()
+	q := NewDelayQueue[int](clock)
+
+	item := q.Schedule(0, clock.Now().Add(time.Second))
+	for i := 0; i < 10000; i++ {
+		is.Nil(q.Reschedule(item, clock.Now().Add(time.Duration(i)*time.Millisecond)))
+	}
+	is.EqualValues(1, q.GetSize())
+	is.LessOrEqual(q.heap.Heap.GetSize(), int32(2*1+64+1))
+
+	deadline, err := q.NextDeadline()
+	is.Nil(err)
+	is.Equal(clock.Now().Add(9999*time.Millisecond), deadline)
+}
+
+func Test_DelayQueueTake(t *testing.T) {
+	is := assert.New(t)
+	clock := newManualClock()
+	q := NewDelayQueue[string](clock)
+
+	done := make(chan string)
+	go func() {
+		value, err := q.Take(context.Background())
+		is.Nil(err)
+		done <- value
+	}()
+
+	q.Schedule("a", clock.Now().Add(time.Second))
+	select {
+	case <-done:
+		t.Fatal("take returned before the deadline")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	clock.Advance(time.Second)
+	is.Equal("a", <-done)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := q.Take(ctx)
+	is.ErrorIs(err, context.DeadlineExceeded)
+}
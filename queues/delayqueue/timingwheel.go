@@ -0,0 +1,252 @@
+package delayqueue
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/OladapoAjala/datastructures/queues/queue"
+)
+
+// TimingWheel is a hierarchical timing wheel. Scheduling and cancelling are
+// O(1); items are released no earlier than their deadline and at most one
+// tick after it, in deadline order within a tick. Deadlines beyond the
+// first wheel spill into coarser overflow wheels that are created on demand.
+type TimingWheel[V any] struct {
+	mu    sync.Mutex
+	clock Clock
+	root  *wheel[V]
+	ready *queue.Queue[*entry[V]]
+	size  int32
+	seq   uint64
+	wake  chan struct{}
+}
+
+type wheel[V any] struct {
+	tick     int64
+	interval int64
+	current  int64
+	buckets  []map[*Item[V]]struct{}
+	overflow *wheel[V]
+}
+
+var _ DelayQueuer[string] = new(TimingWheel[string])
+
+func NewTimingWheel[V any](tick time.Duration, size int32, clock Clock) (*TimingWheel[V], error) {
+	if tick <= 0 {
+		return nil, fmt.Errorf("invalid tick %v", tick)
+	}
+	if size < 2 {
+		return nil, fmt.Errorf("invalid wheel size %d", size)
+	}
+	if clock == nil {
+		clock = RealClock
+	}
+
+	return &TimingWheel[V]{
+		clock: clock,
+		root:  newWheel[V](int64(tick), size, clock.Now().UnixNano()),
+		ready: queue.NewQueue[*entry[V]](),
+	}, nil
+}
+
+func newWheel[V any](tick int64, size int32, now int64) *wheel[V] {
+	return &wheel[V]{
+		tick:     tick,
+		interval: tick * int64(size),
+		current:  now - now%tick,
+		buckets:  make([]map[*Item[V]]struct{}, size),
+	}
+}
+
+func (tw *TimingWheel[V]) Schedule(value V, deadline time.Time) *Item[V] {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	item := &Item[V]{Value: value, owner: tw, seq: tw.seq}
+	tw.seq++
+	tw.push(item, deadline)
+	return item
+}
+
+func (tw *TimingWheel[V]) Cancel(item *Item[V]) error {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	if item.owner != tw {
+		return ErrForeignItem
+	}
+	if !item.queued {
+		return ErrNotScheduled
+	}
+	tw.remove(item)
+	return nil
+}
+
+// Reschedule moves the item to a new deadline, re-adding it if it has
+// already been handed out or cancelled.
+func (tw *TimingWheel[V]) Reschedule(item *Item[V], deadline time.Time) error {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	if item.owner != tw {
+		return ErrForeignItem
+	}
+	if item.queued {
+		tw.remove(item)
+	}
+	tw.push(item, deadline)
+	return nil
+}
+
+// Poll advances the wheel to the current time and returns the next expired
+// item, or ErrNotReady.
+func (tw *TimingWheel[V]) Poll() (V, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	return tw.poll()
+}
+
+// Take blocks until an item is due or ctx is done.
+func (tw *TimingWheel[V]) Take(ctx context.Context) (V, error) {
+	for {
+		tw.mu.Lock()
+		value, err := tw.poll()
+		if err == nil {
+			tw.mu.Unlock()
+			return value, nil
+		}
+
+		var timer <-chan time.Time
+		if tw.size > 0 {
+			next := tw.root.current + tw.root.tick
+			timer = tw.clock.After(time.Duration(next - tw.clock.Now().UnixNano()))
+		}
+		if tw.wake == nil {
+			tw.wake = make(chan struct{})
+		}
+		wake := tw.wake
+		tw.mu.Unlock()
+
+		select {
+		case <-timer:
+		case <-wake:
+		case <-ctx.Done():
+			return *new(V), ctx.Err()
+		}
+	}
+}
+
+func (tw *TimingWheel[V]) GetSize() int32 {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	return tw.size
+}
+
+func (tw *TimingWheel[V]) push(item *Item[V], deadline time.Time) {
+	item.deadline = deadline
+	item.queued = true
+	item.gen++
+	tw.size++
+	tw.add(item)
+	if tw.wake != nil {
+		close(tw.wake)
+		tw.wake = nil
+	}
+}
+
+func (tw *TimingWheel[V]) remove(item *Item[V]) {
+	if item.bucket != nil {
+		delete(item.bucket, item)
+		item.bucket = nil
+	}
+	item.queued = false
+	item.gen++
+	tw.size--
+}
+
+// add places the item in the finest wheel that covers its deadline, or on
+// the ready queue if it has already expired. The deadline is rounded up to a
+// whole tick so an item is never released before it is due.
+func (tw *TimingWheel[V]) add(item *Item[V]) {
+	deadline := item.deadline.UnixNano()
+	if rem := deadline % tw.root.tick; rem > 0 {
+		deadline += tw.root.tick - rem
+	}
+	for w := tw.root; ; w = w.overflow {
+		if deadline <= w.current {
+			// Only reachable on the root wheel; coarser wheels start where
+			// the finer one ends.
+			_ = tw.ready.Enqueue(&entry[V]{item: item, gen: item.gen})
+			return
+		}
+		if deadline < w.current+w.interval {
+			slot := (deadline / w.tick) % int64(len(w.buckets))
+			if w.buckets[slot] == nil {
+				w.buckets[slot] = make(map[*Item[V]]struct{})
+			}
+			w.buckets[slot][item] = struct{}{}
+			item.bucket = w.buckets[slot]
+			return
+		}
+		if w.overflow == nil {
+			w.overflow = newWheel[V](w.interval, int32(len(w.buckets)), w.current)
+		}
+	}
+}
+
+// advance moves every wheel up to now and cascades the buckets whose time
+// range has started back through add.
+func (tw *TimingWheel[V]) advance(now int64) {
+	if now < tw.root.current+tw.root.tick {
+		return
+	}
+
+	flushed := make([]*Item[V], 0)
+	for w := tw.root; w != nil; w = w.overflow {
+		current := now - now%w.tick
+		steps := (current - w.current) / w.tick
+		if steps > int64(len(w.buckets)) {
+			steps = int64(len(w.buckets))
+		}
+		for i := int64(1); i <= steps; i++ {
+			slot := (w.current/w.tick + i) % int64(len(w.buckets))
+			for item := range w.buckets[slot] {
+				item.bucket = nil
+				flushed = append(flushed, item)
+			}
+			w.buckets[slot] = nil
+		}
+		w.current = current
+	}
+
+	sort.Slice(flushed, func(i, j int) bool {
+		a, b := flushed[i], flushed[j]
+		if !a.deadline.Equal(b.deadline) {
+			return a.deadline.Before(b.deadline)
+		}
+		return a.seq < b.seq
+	})
+	for _, item := range flushed {
+		tw.add(item)
+	}
+}
+
+func (tw *TimingWheel[V]) poll() (V, error) {
+	tw.advance(tw.clock.Now().UnixNano())
+	for !tw.ready.IsEmpty() {
+		e, err := tw.ready.Dequeue()
+		if err != nil {
+			return *new(V), err
+		}
+		if e.isStale() {
+			continue
+		}
+		e.item.queued = false
+		tw.size--
+		return e.item.Value, nil
+	}
+	return *new(V), ErrNotReady
+}
@@ -0,0 +1,191 @@
+package delayqueue
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewTimingWheel(t *testing.T) {
+	is := assert.New(t)
+
+	_, err := NewTimingWheel[int](0, 8, nil)
+	is.Error(err)
+	_, err = NewTimingWheel[int](time.Millisecond, 1, nil)
+	is.Error(err)
+
+	tw, err := NewTimingWheel[int](time.Millisecond, 8, nil)
+	is.Nil(err)
+	is.EqualValues(0, tw.GetSize())
+}
+
+func Test_TimingWheelPoll(t *testing.T) {
+	is := assert.New(t)
+	clock := newManualClock()
+	tw, err := NewTimingWheel[string](time.Millisecond, 8, clock)
+	is.Nil(err)
+
+	tw.Schedule("overflow", clock.Now().Add(100*time.Millisecond))
+	tw.Schedule("late", clock.Now().Add(5*time.Millisecond))
+	tw.Schedule("early", clock.Now().Add(3*time.Millisecond))
+	tw.Schedule("now", clock.Now())
+
+	value, err := tw.Poll()
+	is.Nil(err)
+	is.Equal("now", value)
+	_, err = tw.Poll()
+	is.ErrorIs(err, ErrNotReady)
+
+	clock.Advance(10 * time.Millisecond)
+	value, err = tw.Poll()
+	is.Nil(err)
+	is.Equal("early", value)
+	value, err = tw.Poll()
+	is.Nil(err)
+	is.Equal("late", value)
+
+	clock.Advance(89 * time.Millisecond)
+	_, err = tw.Poll()
+	is.ErrorIs(err, ErrNotReady)
+
+	clock.Advance(time.Millisecond)
+	value, err = tw.Poll()
+	is.Nil(err)
+	is.Equal("overflow", value)
+	is.EqualValues(0, tw.GetSize())
+}
+
+func Test_TimingWheelCancelAndReschedule(t *testing.T) {
+	clock := newManualClock()
+	tw, err := NewTimingWheel[string](time.Millisecond, 16, clock)
+	assert.Nil(t, err)
+	testCancelAndReschedule(t, clock, tw)
+}
+
+func Test_TimingWheelManyTimers(t *testing.T) {
+	is := assert.New(t)
+	clock := newManualClock()
+	tw, err := NewTimingWheel[int](time.Millisecond, 32, clock)
+	is.Nil(err)
+
+	rng := rand.New(rand.NewSource(29))
+	start := clock.Now()
+	offsets := make([]int, 0)
+	items := make(map[int]*Item[int])
+	for i := 0; i < 20000; i++ {
+		offset := rng.Intn(1_000_000)
+		items[i] = tw.Schedule(offset, start.Add(time.Duration(offset)*time.Millisecond))
+		offsets = append(offsets, offset)
+	}
+	for i := 0; i < 20000; i += 4 {
+		is.Nil(tw.Cancel(items[i]))
+	}
+
+	expected := make([]int, 0)
+	for i, offset := range offsets {
+		if i%4 != 0 {
+			expected = append(expected, offset)
+		}
+	}
+	sort.Ints(expected)
+
+	got := make([]int, 0)
+	for len(got) < len(expected) {
+		clock.Advance(time.Duration(rng.Intn(5000)) * time.Millisecond)
+		now := clock.Now()
+		for {
+			offset, err := tw.Poll()
+			if err != nil {
+				is.ErrorIs(err, ErrNotReady)
+				break
+			}
+			is.False(start.Add(time.Duration(offset) * time.Millisecond).After(now))
+			got = append(got, offset)
+		}
+	}
+	is.Equal(expected, got)
+	is.EqualValues(0, tw.GetSize())
+}
+
+func Test_TimingWheelUnalignedDeadlines(t *testing.T) {
+	is := assert.New(t)
+	clock := newManualClock()
+	tw, err := NewTimingWheel[string](time.Second, 8, clock)
+	is.Nil(err)
+
+	tw.Schedule("first", clock.Now().Add(600*time.Millisecond))
+	tw.Schedule("second", clock.Now().Add(1400*time.Millisecond))
+	_, err = tw.Poll()
+	is.ErrorIs(err, ErrNotReady)
+
+	clock.Advance(500 * time.Millisecond)
+	_, err = tw.Poll()
+	is.ErrorIs(err, ErrNotReady)
+
+	clock.Advance(500 * time.Millisecond)
+	value, err := tw.Poll()
+	is.Nil(err)
+	is.Equal("first", value)
+	_, err = tw.Poll()
+	is.ErrorIs(err, ErrNotReady)
+
+	clock.Advance(time.Second)
+	value, err = tw.Poll()
+	is.Nil(err)
+	is.Equal("second", value)
+
+	rng := rand.New(rand.NewSource(29))
+	start := clock.Now()
+	pending := make(map[int]time.Time)
+	for i := 0; i < 5000; i++ {
+		pending[i] = start.Add(time.Duration(rng.Int63n(int64(30 * time.Second))))
+		tw.Schedule(strconv.Itoa(i), pending[i])
+	}
+	for len(pending) > 0 {
+		clock.Advance(time.Duration(rng.Int63n(int64(2 * time.Second))))
+		now := clock.Now()
+		for {
+			value, err := tw.Poll()
+			if err != nil {
+				break
+			}
+			i, _ := strconv.Atoi(value)
+			is.False(pending[i].After(now))
+			delete(pending, i)
+		}
+		for _, deadline := range pending {
+			is.True(now.Before(deadline.Add(time.Second)))
+		}
+	}
+}
+
+func Test_TimingWheelTake(t *testing.T) {
+	is := assert.New(t)
+	clock := newManualClock()
+	tw, err := NewTimingWheel[string](time.Millisecond, 8, clock)
+	is.Nil(err)
+
+	done := make(chan string)
+	go func() {
+		value, err := tw.Take(context.Background())
+		is.Nil(err)
+		done <- value
+	}()
+
+	tw.Schedule("a", clock.Now().Add(20*time.Millisecond))
+	select {
+	case <-done:
+		t.Fatal("take returned before the deadline")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	for i := 0; i < 20; i++ {
+		clock.Advance(time.Millisecond)
+	}
+	is.Equal("a", <-done)
+}
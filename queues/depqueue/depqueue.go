@@ -0,0 +1,113 @@
+package depqueue
+
+import (
+	"fmt"
+
+	"github.com/OladapoAjala/datastructures/heap/data"
+	"github.com/OladapoAjala/datastructures/heap/minmaxheap"
+	"golang.org/x/exp/constraints"
+)
+
+// Evict selects which end of a full queue gives way to a new item.
+type Evict int
+
+const (
+	EvictMin Evict = iota // keep the largest keys
+	EvictMax              // keep the smallest keys
+)
+
+// DEPQueue is a double-ended priority queue. With a non-zero capacity it
+// holds at most that many items, evicting from the end chosen by Evict.
+type DEPQueue[K constraints.Ordered, V comparable] struct {
+	*minmaxheap.MinMaxHeap[K, V]
+	capacity int32
+	evict    Evict
+}
+
+type IDEPQueue[K constraints.Ordered, V comparable] interface {
+	Enqueue(K, V) (*data.Data[K, V], error)
+	DequeueMin() (K, V, error)
+	DequeueMax() (K, V, error)
+	GetCapacity() int32
+}
+
+var _ IDEPQueue[int, string] = new(DEPQueue[int, string])
+
+func NewDEPQueue[K constraints.Ordered, V comparable]() *DEPQueue[K, V] {
+	return NewBoundedDEPQueue[K, V](0, EvictMin)
+}
+
+func NewBoundedDEPQueue[K constraints.Ordered, V comparable](capacity int32, evict Evict) *DEPQueue[K, V] {
+	return &DEPQueue[K, V]{
+		MinMaxHeap: minmaxheap.NewMinMaxHeap[K, V](),
+		capacity:   capacity,
+		evict:      evict,
+	}
+}
+
+// Enqueue adds the item and returns whatever was evicted to make room for
+// it, which may be the new item itself. It returns nil when nothing was
+// evicted.
+func (pq *DEPQueue[K, V]) Enqueue(key K, val V) (*data.Data[K, V], error) {
+	if pq.capacity < 0 {
+		return nil, fmt.Errorf("invalid capacity %d", pq.capacity)
+	}
+	if pq.capacity == 0 || pq.GetSize() < pq.capacity {
+		return nil, pq.Insert(key, val)
+	}
+
+	switch pq.evict {
+	case EvictMin:
+		min, err := pq.FindMin()
+		if err != nil {
+			return nil, err
+		}
+		if key <= min.GetKey() {
+			return data.NewData(key, val, -1), nil
+		}
+		evicted, err := pq.DeleteMin()
+		if err != nil {
+			return nil, err
+		}
+		return evicted, pq.Insert(key, val)
+	case EvictMax:
+		max, err := pq.FindMax()
+		if err != nil {
+			return nil, err
+		}
+		if key >= max.GetKey() {
+			return data.NewData(key, val, -1), nil
+		}
+		evicted, err := pq.DeleteMax()
+		if err != nil {
+			return nil, err
+		}
+		return evicted, pq.Insert(key, val)
+	default:
+		return nil, fmt.Errorf("unknown eviction policy %d", pq.evict)
+	}
+}
+
+func (pq *DEPQueue[K, V]) DequeueMin() (K, V, error) {
+	min, err := pq.DeleteMin()
+	if err != nil {
+		return *new(K), *new(V), err
+	}
+	return min.GetKey(), min.GetValue(), nil
+}
+
+func (pq *DEPQueue[K, V]) DequeueMax() (K, V, error) {
+	max, err := pq.DeleteMax()
+	if err != nil {
+		return *new(K), *new(V), err
+	}
+	return max.GetKey(), max.GetValue(), nil
+}
+
+func (pq *DEPQueue[K, V]) GetCapacity() int32 {
+	return pq.capacity
+}
+
+func (pq *DEPQueue[K, V]) IsFull() bool {
+	return pq.capacity > 0 && pq.GetSize() >= pq.capacity
+}
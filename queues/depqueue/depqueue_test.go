@@ -0,0 +1,132 @@
+package depqueue
+
+import (
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/heap/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Dequeue(t *testing.T) {
+	is := assert.New(t)
+	pq := NewDEPQueue[int, string]()
+
+	for key, value := range map[int]string{2: "C", 0: "A", 4: "E", 1: "B", 3: "D"} {
+		evicted, err := pq.Enqueue(key, value)
+		is.Nil(err)
+		is.Nil(evicted)
+	}
+
+	key, value, err := pq.DequeueMax()
+	is.Nil(err)
+	is.Equal(4, key)
+	is.Equal("E", value)
+
+	key, value, err = pq.DequeueMin()
+	is.Nil(err)
+	is.Equal(0, key)
+	is.Equal("A", value)
+
+	key, value, err = pq.DequeueMin()
+	is.Nil(err)
+	is.Equal(1, key)
+	is.Equal("B", value)
+	is.EqualValues(2, pq.GetSize())
+
+	_, _, err = NewDEPQueue[int, string]().DequeueMax()
+	is.Error(err, "empty heap")
+}
+
+func Test_Enqueue(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name  string
+		evict Evict
+		keys  []int
+		key   int
+		want  func(*DEPQueue[int, int], *data.Data[int, int], error)
+	}{
+		{
+			name:  "enqueue below capacity",
+			evict: EvictMin,
+			keys:  []int{5, 3},
+			key:   4,
+			want: func(pq *DEPQueue[int, int], evicted *data.Data[int, int], err error) {
+				is.Nil(err)
+				is.Nil(evicted)
+				is.EqualValues(3, pq.GetSize())
+				is.True(pq.IsFull())
+			},
+		},
+		{
+			name:  "evict min when full",
+			evict: EvictMin,
+			keys:  []int{5, 3, 4},
+			key:   6,
+			want: func(pq *DEPQueue[int, int], evicted *data.Data[int, int], err error) {
+				is.Nil(err)
+				is.Equal(3, evicted.GetKey())
+				is.EqualValues(3, pq.GetSize())
+				min, err := pq.FindMin()
+				is.Nil(err)
+				is.Equal(4, min.GetKey())
+				max, err := pq.FindMax()
+				is.Nil(err)
+				is.Equal(6, max.GetKey())
+			},
+		},
+		{
+			name:  "reject new item smaller than min",
+			evict: EvictMin,
+			keys:  []int{5, 3, 4},
+			key:   1,
+			want: func(pq *DEPQueue[int, int], evicted *data.Data[int, int], err error) {
+				is.Nil(err)
+				is.Equal(1, evicted.GetKey())
+				min, err := pq.FindMin()
+				is.Nil(err)
+				is.Equal(3, min.GetKey())
+			},
+		},
+		{
+			name:  "evict max when full",
+			evict: EvictMax,
+			keys:  []int{5, 3, 4},
+			key:   1,
+			want: func(pq *DEPQueue[int, int], evicted *data.Data[int, int], err error) {
+				is.Nil(err)
+				is.Equal(5, evicted.GetKey())
+				max, err := pq.FindMax()
+				is.Nil(err)
+				is.Equal(4, max.GetKey())
+				min, err := pq.FindMin()
+				is.Nil(err)
+				is.Equal(1, min.GetKey())
+			},
+		},
+		{
+			name:  "reject new item larger than max",
+			evict: EvictMax,
+			keys:  []int{5, 3, 4},
+			key:   8,
+			want: func(pq *DEPQueue[int, int], evicted *data.Data[int, int], err error) {
+				is.Nil(err)
+				is.Equal(8, evicted.GetKey())
+				is.EqualValues(3, pq.GetSize())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pq := NewBoundedDEPQueue[int, int](3, tt.evict)
+			for _, k := range tt.keys {
+				_, err := pq.Enqueue(k, k)
+				is.Nil(err)
+			}
+			evicted, err := pq.Enqueue(tt.key, tt.key)
+			tt.want(pq, evicted, err)
+		})
+	}
+}
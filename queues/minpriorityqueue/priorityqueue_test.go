@@ -86,3 +86,60 @@ func Test_Dequeue(t *testing.T) {
 		})
 	}
 }
+
+func Test_StableDequeue(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name  string
+		setup func() *PQueue[int, int]
+		want  func(*PQueue[int, int])
+	}{
+		{
+			name: "deque equal keys in insertion order",
+			setup: func() *PQueue[int, int] {
+				pq := NewStablePQueue[int, int]()
+				for i := 0; i < 5000; i++ {
+					is.Nil(pq.Enqueue(7, i))
+				}
+				return pq
+			},
+			want: func(pq *PQueue[int, int]) {
+				for i := 0; i < 5000; i++ {
+					key, value, err := pq.Dequeue()
+					is.Nil(err)
+					is.Equal(7, key)
+					is.Equal(i, value)
+				}
+				is.True(pq.IsEmpty())
+			},
+		},
+		{
+			name: "deque mixed keys in insertion order within each key",
+			setup: func() *PQueue[int, int] {
+				pq := NewStablePQueue[int, int]()
+				for i := 0; i < 6000; i++ {
+					is.Nil(pq.Enqueue(i%3, i))
+				}
+				return pq
+			},
+			want: func(pq *PQueue[int, int]) {
+				for _, key := range []int{0, 1, 2} {
+					for i := key; i < 6000; i += 3 {
+						k, value, err := pq.Dequeue()
+						is.Nil(err)
+						is.Equal(key, k)
+						is.Equal(i, value)
+					}
+				}
+				is.True(pq.IsEmpty())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want(tt.setup())
+		})
+	}
+}
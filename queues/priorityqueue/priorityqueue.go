@@ -20,6 +20,14 @@ func NewPQueue[K constraints.Ordered, V comparable]() *PQueue[K, V] {
 	}
 }
 
+// NewStablePQueue returns a queue that dequeues items of equal priority in
+// the order they were enqueued.
+func NewStablePQueue[K constraints.Ordered, V comparable]() *PQueue[K, V] {
+	return &PQueue[K, V]{
+		maxheap.NewStableMaxHeap[K, V](),
+	}
+}
+
 func (pq *PQueue[K, V]) Dequeue() (K, V, error) {
 	max, err := pq.DeleteMax()
 	if err != nil {
@@ -0,0 +1,213 @@
+package syncpriorityqueue
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/OladapoAjala/datastructures/queues/minpriorityqueue"
+	"github.com/OladapoAjala/datastructures/queues/priorityqueue"
+	"golang.org/x/exp/constraints"
+)
+
+var (
+	ErrClosed = errors.New("queue closed")
+	ErrEmpty  = errors.New("empty queue")
+	ErrFull   = errors.New("queue full")
+)
+
+// Queuer is the non thread-safe queue a PQueue guards. Both
+// priorityqueue.PQueue and minpriorityqueue.PQueue satisfy it.
+type Queuer[K constraints.Ordered, V comparable] interface {
+	Enqueue(K, V) error
+	Dequeue() (K, V, error)
+}
+
+// PQueue is a priority queue that is safe for concurrent use. A capacity of
+// zero means the queue is unbounded.
+type PQueue[K constraints.Ordered, V comparable] struct {
+	mu       sync.Mutex
+	queue    Queuer[K, V]
+	size     int32
+	capacity int32
+	closed   bool
+	notEmpty chan struct{}
+	notFull  chan struct{}
+}
+
+type IPQueue[K constraints.Ordered, V comparable] interface {
+	Enqueue(context.Context, K, V) error
+	TryEnqueue(K, V) error
+	Dequeue(context.Context) (K, V, error)
+	TryDequeue() (K, V, error)
+	Close()
+	GetSize() int32
+	GetCapacity() int32
+}
+
+var _ IPQueue[int, string] = new(PQueue[int, string])
+
+// New wraps q, which must be empty and must not be used directly afterwards.
+func New[K constraints.Ordered, V comparable](q Queuer[K, V], capacity int32) *PQueue[K, V] {
+	return &PQueue[K, V]{
+		queue:    q,
+		capacity: capacity,
+	}
+}
+
+// NewPQueue returns a max-first queue that breaks ties in FIFO order.
+func NewPQueue[K constraints.Ordered, V comparable](capacity int32) *PQueue[K, V] {
+	return New[K, V](priorityqueue.NewStablePQueue[K, V](), capacity)
+}
+
+// NewMinPQueue returns a min-first queue that breaks ties in FIFO order.
+func NewMinPQueue[K constraints.Ordered, V comparable](capacity int32) *PQueue[K, V] {
+	return New[K, V](minpriorityqueue.NewStablePQueue[K, V](), capacity)
+}
+
+// Enqueue blocks while the queue is full. It returns ErrClosed if the queue
+// is closed before the item could be added.
+func (pq *PQueue[K, V]) Enqueue(ctx context.Context, key K, val V) error {
+	for {
+		pq.mu.Lock()
+		if pq.closed {
+			pq.mu.Unlock()
+			return ErrClosed
+		}
+		if !pq.isFull() {
+			err := pq.enqueue(key, val)
+			pq.mu.Unlock()
+			return err
+		}
+		if pq.notFull == nil {
+			pq.notFull = make(chan struct{})
+		}
+		wait := pq.notFull
+		pq.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// TryEnqueue returns ErrFull instead of blocking when the queue is full.
+func (pq *PQueue[K, V]) TryEnqueue(key K, val V) error {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	if pq.closed {
+		return ErrClosed
+	}
+	if pq.isFull() {
+		return ErrFull
+	}
+	return pq.enqueue(key, val)
+}
+
+// Dequeue blocks while the queue is empty. Items left in a closed queue can
+// still be dequeued; once it is drained Dequeue returns ErrClosed.
+func (pq *PQueue[K, V]) Dequeue(ctx context.Context) (K, V, error) {
+	for {
+		pq.mu.Lock()
+		if pq.size > 0 {
+			key, val, err := pq.dequeue()
+			pq.mu.Unlock()
+			return key, val, err
+		}
+		if pq.closed {
+			pq.mu.Unlock()
+			return *new(K), *new(V), ErrClosed
+		}
+		if pq.notEmpty == nil {
+			pq.notEmpty = make(chan struct{})
+		}
+		wait := pq.notEmpty
+		pq.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return *new(K), *new(V), ctx.Err()
+		}
+	}
+}
+
+// TryDequeue returns ErrEmpty instead of blocking when the queue is empty.
+func (pq *PQueue[K, V]) TryDequeue() (K, V, error) {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	if pq.size > 0 {
+		return pq.dequeue()
+	}
+	if pq.closed {
+		return *new(K), *new(V), ErrClosed
+	}
+	return *new(K), *new(V), ErrEmpty
+}
+
+// Close stops the queue from accepting items and wakes every blocked caller.
+// Calling Close more than once has no effect.
+func (pq *PQueue[K, V]) Close() {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	if pq.closed {
+		return
+	}
+	pq.closed = true
+	pq.signal(&pq.notEmpty)
+	pq.signal(&pq.notFull)
+}
+
+func (pq *PQueue[K, V]) IsClosed() bool {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	return pq.closed
+}
+
+func (pq *PQueue[K, V]) GetSize() int32 {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	return pq.size
+}
+
+func (pq *PQueue[K, V]) GetCapacity() int32 {
+	return pq.capacity
+}
+
+func (pq *PQueue[K, V]) enqueue(key K, val V) error {
+	err := pq.queue.Enqueue(key, val)
+	if err != nil {
+		return err
+	}
+	pq.size++
+	pq.signal(&pq.notEmpty)
+	return nil
+}
+
+func (pq *PQueue[K, V]) dequeue() (K, V, error) {
+	key, val, err := pq.queue.Dequeue()
+	if err != nil {
+		return *new(K), *new(V), err
+	}
+	pq.size--
+	pq.signal(&pq.notFull)
+	return key, val, nil
+}
+
+func (pq *PQueue[K, V]) isFull() bool {
+	return pq.capacity > 0 && pq.size >= pq.capacity
+}
+
+// signal wakes everyone waiting on ch. Waiters re-check the queue state
+// under the lock, so spurious wake-ups are harmless.
+func (pq *PQueue[K, V]) signal(ch *chan struct{}) {
+	if *ch != nil {
+		close(*ch)
+		*ch = nil
+	}
+}
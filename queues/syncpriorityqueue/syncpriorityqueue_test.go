@@ -0,0 +1,208 @@
+package syncpriorityqueue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Dequeue(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name  string
+		setup func() *PQueue[int, string]
+		want  func(*PQueue[int, string], int, string, error)
+	}{
+		{
+			name: "dequeue highest priority",
+			setup: func() *PQueue[int, string] {
+				pq := NewPQueue[int, string](0)
+				is.Nil(pq.TryEnqueue(1, "B"))
+				is.Nil(pq.TryEnqueue(2, "C"))
+				is.Nil(pq.TryEnqueue(0, "A"))
+				return pq
+			},
+			want: func(pq *PQueue[int, string], key int, value string, err error) {
+				is.Nil(err)
+				is.Equal(2, key)
+				is.Equal("C", value)
+				is.EqualValues(2, pq.GetSize())
+			},
+		},
+		{
+			name: "dequeue lowest priority from min queue",
+			setup: func() *PQueue[int, string] {
+				pq := NewMinPQueue[int, string](0)
+				is.Nil(pq.TryEnqueue(1, "B"))
+				is.Nil(pq.TryEnqueue(0, "A"))
+				return pq
+			},
+			want: func(pq *PQueue[int, string], key int, value string, err error) {
+				is.Nil(err)
+				is.Equal(0, key)
+				is.Equal("A", value)
+			},
+		},
+		{
+			name: "dequeue from empty queue times out",
+			setup: func() *PQueue[int, string] {
+				return NewPQueue[int, string](0)
+			},
+			want: func(pq *PQueue[int, string], key int, value string, err error) {
+				is.ErrorIs(err, context.DeadlineExceeded)
+				is.Empty(value)
+			},
+		},
+		{
+			name: "dequeue drains closed queue",
+			setup: func() *PQueue[int, string] {
+				pq := NewPQueue[int, string](0)
+				is.Nil(pq.TryEnqueue(1, "A"))
+				pq.Close()
+				return pq
+			},
+			want: func(pq *PQueue[int, string], key int, value string, err error) {
+				is.Nil(err)
+				is.Equal("A", value)
+
+				_, _, err = pq.Dequeue(context.Background())
+				is.ErrorIs(err, ErrClosed)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pq := tt.setup()
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+			key, value, err := pq.Dequeue(ctx)
+			tt.want(pq, key, value, err)
+		})
+	}
+}
+
+func Test_TryOperations(t *testing.T) {
+	is := assert.New(t)
+	pq := NewPQueue[int, int](2)
+
+	_, _, err := pq.TryDequeue()
+	is.ErrorIs(err, ErrEmpty)
+
+	is.Nil(pq.TryEnqueue(1, 1))
+	is.Nil(pq.TryEnqueue(2, 2))
+	is.ErrorIs(pq.TryEnqueue(3, 3), ErrFull)
+	is.EqualValues(2, pq.GetSize())
+
+	key, _, err := pq.TryDequeue()
+	is.Nil(err)
+	is.Equal(2, key)
+	is.Nil(pq.TryEnqueue(3, 3))
+
+	pq.Close()
+	is.True(pq.IsClosed())
+	is.ErrorIs(pq.TryEnqueue(4, 4), ErrClosed)
+}
+
+func Test_BlockingEnqueue(t *testing.T) {
+	is := assert.New(t)
+	pq := NewPQueue[int, int](1)
+	is.Nil(pq.TryEnqueue(1, 1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	is.ErrorIs(pq.Enqueue(ctx, 2, 2), context.DeadlineExceeded)
+
+	done := make(chan error)
+	go func() {
+		done <- pq.Enqueue(context.Background(), 2, 2)
+	}()
+	key, _, err := pq.Dequeue(context.Background())
+	is.Nil(err)
+	is.Equal(1, key)
+	is.Nil(<-done)
+
+	go func() {
+		done <- pq.Enqueue(context.Background(), 3, 3)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	pq.Close()
+	is.ErrorIs(<-done, ErrClosed)
+}
+
+func Test_CloseWakesConsumers(t *testing.T) {
+	is := assert.New(t)
+	pq := NewPQueue[int, int](0)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := pq.Dequeue(context.Background())
+			errs <- err
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	pq.Close()
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		is.ErrorIs(err, ErrClosed)
+	}
+}
+
+func Test_ProducersConsumers(t *testing.T) {
+	is := assert.New(t)
+	pq := NewPQueue[int, int](64)
+
+	const producers, consumers, perProducer = 8, 8, 2000
+	var producerWg, consumerWg sync.WaitGroup
+	seen := make([][]int, consumers)
+
+	for c := 0; c < consumers; c++ {
+		consumerWg.Add(1)
+		go func(c int) {
+			defer consumerWg.Done()
+			for {
+				_, value, err := pq.Dequeue(context.Background())
+				if err != nil {
+					is.ErrorIs(err, ErrClosed)
+					return
+				}
+				seen[c] = append(seen[c], value)
+			}
+		}(c)
+	}
+
+	for p := 0; p < producers; p++ {
+		producerWg.Add(1)
+		go func(p int) {
+			defer producerWg.Done()
+			for i := 0; i < perProducer; i++ {
+				value := p*perProducer + i
+				is.Nil(pq.Enqueue(context.Background(), value%10, value))
+			}
+		}(p)
+	}
+
+	producerWg.Wait()
+	pq.Close()
+	consumerWg.Wait()
+
+	received := make(map[int]bool)
+	for _, values := range seen {
+		for _, v := range values {
+			is.False(received[v], "value %d dequeued twice", v)
+			received[v] = true
+		}
+	}
+	is.Len(received, producers*perProducer)
+	is.EqualValues(0, pq.GetSize())
+}
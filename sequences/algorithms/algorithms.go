@@ -0,0 +1,144 @@
+// Package algorithms holds the higher-order operations shared by every
+// sequence. The transformations take and return iter.Seq values, so they
+// are lazy and compose: Filter(Map(list.Values(), f), pred) visits the list
+// once, when the result is ranged over or collected.
+package algorithms
+
+import (
+	"iter"
+)
+
+// Appender is the part of sequences.Sequencer that Collect needs.
+type Appender[T any] interface {
+	InsertLast(T) error
+}
+
+// Collect appends every element of seq to dst.
+func Collect[T any](dst Appender[T], seq iter.Seq[T]) error {
+	for v := range seq {
+		if err := dst.InsertLast(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func Map[T, U any](seq iter.Seq[T], f func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+func Filter[T any](seq iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if pred(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Reduce folds seq into a single value, starting from init.
+func Reduce[T, U any](seq iter.Seq[T], init U, f func(U, T) U) U {
+	acc := init
+	for v := range seq {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// Any reports whether pred holds for some element. It stops at the first
+// match.
+func Any[T any](seq iter.Seq[T], pred func(T) bool) bool {
+	_, ok := Find(seq, pred)
+	return ok
+}
+
+// All reports whether pred holds for every element, which is true of an
+// empty sequence. It stops at the first mismatch.
+func All[T any](seq iter.Seq[T], pred func(T) bool) bool {
+	return !Any(seq, func(v T) bool { return !pred(v) })
+}
+
+// Find returns the first element for which pred holds.
+func Find[T any](seq iter.Seq[T], pred func(T) bool) (T, bool) {
+	for v := range seq {
+		if pred(v) {
+			return v, true
+		}
+	}
+	return *new(T), false
+}
+
+// Partition splits seq into the elements for which pred holds and the rest,
+// each in their original order.
+func Partition[T any](seq iter.Seq[T], pred func(T) bool) (matched, rest []T) {
+	for v := range seq {
+		if pred(v) {
+			matched = append(matched, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return matched, rest
+}
+
+// Chunk groups seq into slices of size elements; the last may be shorter.
+// Each chunk is a new slice. Chunk panics if size is less than 1.
+func Chunk[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size < 1 {
+		panic("algorithms: chunk size must be positive")
+	}
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		for v := range seq {
+			chunk = append(chunk, v)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Zip pairs the elements of a and b in order, stopping at the end of the
+// shorter one.
+func Zip[A, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		next, stop := iter.Pull(b)
+		defer stop()
+		for x := range a {
+			y, ok := next()
+			if !ok || !yield(x, y) {
+				return
+			}
+		}
+	}
+}
+
+// Distinct yields the first occurrence of each element. It remembers every
+// element it has seen, so it uses memory proportional to their number.
+func Distinct[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[T]struct{})
+		for v := range seq {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package algorithms
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/sequences/dynamicarray"
+	"github.com/OladapoAjala/datastructures/sequences/linkedlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Algorithms(t *testing.T) {
+	is := assert.New(t)
+	list := linkedlist.NewList(1, 2, 3, 4, 5, 6, 7)
+	even := func(v int) bool { return v%2 == 0 }
+
+	tests := []struct {
+		name string
+		want func()
+	}{
+		{
+			name: "map and filter compose",
+			want: func() {
+				doubled := Map(Filter(list.Values(), even), func(v int) string {
+					return strconv.Itoa(2 * v)
+				})
+				is.Equal([]string{"4", "8", "12"}, slices.Collect(doubled))
+			},
+		},
+		{
+			name: "reduce",
+			want: func() {
+				is.Equal(28, Reduce(list.Values(), 0, func(acc, v int) int { return acc + v }))
+				is.Equal("x", Reduce(linkedlist.NewList[int]().Values(), "x", func(acc string, v int) string { return acc + "!" }))
+			},
+		},
+		{
+			name: "any, all and find",
+			want: func() {
+				is.True(Any(list.Values(), even))
+				is.False(All(list.Values(), even))
+				is.True(All(list.Values(), func(v int) bool { return v > 0 }))
+				is.True(All(linkedlist.NewList[int]().Values(), even))
+
+				v, ok := Find(list.Values(), func(v int) bool { return v > 4 })
+				is.True(ok)
+				is.Equal(5, v)
+				_, ok = Find(list.Values(), func(v int) bool { return v > 7 })
+				is.False(ok)
+			},
+		},
+		{
+			name: "partition",
+			want: func() {
+				matched, rest := Partition(list.Values(), even)
+				is.Equal([]int{2, 4, 6}, matched)
+				is.Equal([]int{1, 3, 5, 7}, rest)
+			},
+		},
+		{
+			name: "chunk",
+			want: func() {
+				is.Equal([][]int{{1, 2, 3}, {4, 5, 6}, {7}}, slices.Collect(Chunk(list.Values(), 3)))
+				is.Equal([][]int{{1, 2, 3, 4, 5, 6, 7}}, slices.Collect(Chunk(list.Values(), 10)))
+				is.Panics(func() { Chunk(list.Values(), 0) })
+			},
+		},
+		{
+			name: "zip stops at the shorter",
+			want: func() {
+				names := dynamicarray.NewDynamicArray("a", "b", "c")
+				var pairs []string
+				for n, v := range Zip(names.Values(), list.Values()) {
+					pairs = append(pairs, n+strconv.Itoa(v))
+				}
+				is.Equal([]string{"a1", "b2", "c3"}, pairs)
+			},
+		},
+		{
+			name: "distinct",
+			want: func() {
+				dups := linkedlist.NewList(3, 1, 3, 2, 1, 0, 0)
+				is.Equal([]int{3, 1, 2, 0}, slices.Collect(Distinct(dups.Values())))
+			},
+		},
+		{
+			name: "stops early",
+			want: func() {
+				var seen []int
+				for v := range Map(list.Values(), func(v int) int {
+					seen = append(seen, v)
+					return v
+				}) {
+					if v == 2 {
+						break
+					}
+				}
+				is.Equal([]int{1, 2}, seen)
+			},
+		},
+		{
+			name: "collect into a sequence",
+			want: func() {
+				dst := dynamicarray.NewDynamicArray[int]()
+				is.Nil(Collect(dst, Filter(list.Values(), even)))
+				is.Equal([]int{2, 4, 6}, slices.Collect(dst.Values()))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want()
+		})
+	}
+}
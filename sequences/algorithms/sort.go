@@ -0,0 +1,89 @@
+package algorithms
+
+import (
+	"slices"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+	"golang.org/x/exp/constraints"
+)
+
+// INSERTION_SORT_SIZE is the run length below which merge sort switches to
+// insertion sort.
+const INSERTION_SORT_SIZE = 12
+
+// Sort sorts s in ascending order. It is stable.
+func Sort[T constraints.Ordered](s sequences.Sequencer[T]) error {
+	return SortFunc(s, func(a, b T) bool { return a < b })
+}
+
+// SortFunc stably sorts s by less. It reads the elements out with one pass
+// of s.Values, merge sorts them in O(n log n) and writes them back in one
+// pass if s is a sequences.Assigner, or with Set otherwise.
+func SortFunc[T any](s sequences.Sequencer[T], less func(a, b T) bool) error {
+	items := make([]T, 0, s.GetSize())
+	for v := range s.Values() {
+		items = append(items, v)
+	}
+
+	mergeSort(items, make([]T, len(items)), less)
+
+	if a, ok := s.(sequences.Assigner[T]); ok {
+		a.Assign(slices.Values(items))
+		return nil
+	}
+	for i, v := range items {
+		if err := s.Set(int32(i), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsSorted reports whether s is in order by less.
+func IsSorted[T any](s sequences.Sequencer[T], less func(a, b T) bool) bool {
+	var prev T
+	first := true
+	for v := range s.Values() {
+		if !first && less(v, prev) {
+			return false
+		}
+		prev, first = v, false
+	}
+	return true
+}
+
+// mergeSort sorts items using buf, which is as long as items, as scratch
+// space. Ties take the element from the left run, which keeps it stable.
+func mergeSort[T any](items, buf []T, less func(a, b T) bool) {
+	if len(items) <= INSERTION_SORT_SIZE {
+		insertionSort(items, less)
+		return
+	}
+
+	mid := len(items) / 2
+	mergeSort(items[:mid], buf[:mid], less)
+	mergeSort(items[mid:], buf[mid:], less)
+	if !less(items[mid], items[mid-1]) {
+		return
+	}
+
+	copy(buf, items)
+	i, j := 0, mid
+	for k := range items {
+		if j == len(items) || i < mid && !less(buf[j], buf[i]) {
+			items[k] = buf[i]
+			i++
+		} else {
+			items[k] = buf[j]
+			j++
+		}
+	}
+}
+
+func insertionSort[T any](items []T, less func(a, b T) bool) {
+	for i := 1; i < len(items); i++ {
+		for j := i; j > 0 && less(items[j], items[j-1]); j-- {
+			items[j], items[j-1] = items[j-1], items[j]
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package algorithms
+
+import (
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+	"github.com/OladapoAjala/datastructures/sequences/dynamicarray"
+	"github.com/OladapoAjala/datastructures/sequences/linkedlist"
+	"github.com/OladapoAjala/datastructures/sequences/staticarray"
+	"github.com/OladapoAjala/datastructures/trees/binarytree"
+	"github.com/stretchr/testify/assert"
+)
+
+type record struct {
+	key int
+	tag int
+}
+
+var sequencers = []struct {
+	name string
+	new  func(...record) sequences.Sequencer[record]
+}{
+	{
+		name: "dynamic_array",
+		new:  func(r ...record) sequences.Sequencer[record] { return dynamicarray.NewDynamicArray(r...) },
+	},
+	{
+		name: "static_array",
+		new: func(r ...record) sequences.Sequencer[record] {
+			return staticarray.NewStaticArray(int32(len(r)), r...)
+		},
+	},
+	{
+		name: "linked_list",
+		new:  func(r ...record) sequences.Sequencer[record] { return linkedlist.NewList(r...) },
+	},
+	{
+		name: "binary_tree",
+		new:  func(r ...record) sequences.Sequencer[record] { return binarytree.NewBinaryTree(r...) },
+	},
+}
+
+func Test_SortFunc(t *testing.T) {
+	is := assert.New(t)
+	byKey := func(a, b record) bool { return a.key < b.key }
+
+	for _, size := range []int{0, 1, 2, 11, 12, 13, 100, 500} {
+		r := rand.New(rand.NewSource(int64(size)))
+		records := make([]record, size)
+		for i := range records {
+			// Few distinct keys, so there are many ties; the tag records
+			// the original order.
+			records[i] = record{key: r.Intn(10), tag: i + 1}
+		}
+		want := slices.Clone(records)
+		sort.SliceStable(want, func(i, j int) bool { return want[i].key < want[j].key })
+
+		for _, tt := range sequencers {
+			t.Run(tt.name, func(t *testing.T) {
+				s := tt.new(records...)
+				is.Nil(SortFunc(s, byKey))
+				is.True(IsSorted(s, byKey))
+				is.EqualValues(size, s.GetSize())
+				if size > 0 {
+					is.Equal(want, slices.Collect(s.Values()), "size %d", size)
+				}
+			})
+		}
+	}
+}
+
+func Test_Sort(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{name: "reversed", in: []int{5, 4, 3, 2, 1}, want: []int{1, 2, 3, 4, 5}},
+		{name: "sorted", in: []int{1, 2, 3}, want: []int{1, 2, 3}},
+		{name: "duplicates", in: []int{2, 1, 2, 1}, want: []int{1, 1, 2, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := linkedlist.NewList(tt.in...)
+			is.Nil(Sort[int](list))
+			is.Equal(tt.want, slices.Collect(list.Values()))
+			is.True(IsSorted[int](list, func(a, b int) bool { return a < b }))
+		})
+	}
+}
+
+// Test_SortLargeList would take minutes if the write-back walked the list
+// from the head for every element.
+func Test_SortLargeList(t *testing.T) {
+	is := assert.New(t)
+	r := rand.New(rand.NewSource(46))
+
+	values := make([]int, 200_000)
+	for i := range values {
+		values[i] = r.Int()
+	}
+	list := linkedlist.NewList(values...)
+
+	is.Nil(Sort[int](list))
+	slices.Sort(values)
+	is.Equal(values, slices.Collect(list.Values()))
+}
@@ -0,0 +1,238 @@
+package deque
+
+import (
+	"fmt"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+)
+
+// Deque is a double-ended queue in a circular array. The elements occupy
+// length consecutive slots starting at head, wrapping past the end of the
+// array, so both ends are open for O(1) inserts and deletes and indexing
+// is O(1). Inserts and deletes in the middle shift whichever side of the
+// index is shorter.
+//
+// A growable deque doubles its array when full and halves it when a
+// quarter full. A ring buffer, made by NewRingBuffer, keeps a fixed
+// capacity: inserting at either end of a full ring buffer overwrites the
+// element at the other end, so InsertLast keeps the newest elements.
+type Deque[T comparable] struct {
+	array  []T
+	head   int32
+	length int32
+	fixed  bool
+	// version counts structural changes, for fail-fast iteration.
+	version uint64
+}
+
+type IDeque[T comparable] interface {
+	sequences.Sequencer[T]
+	First() (T, error)
+	Last() (T, error)
+	PopFirst() (T, error)
+	PopLast() (T, error)
+	Capacity() int32
+	IsFull() bool
+}
+
+var _ IDeque[string] = new(Deque[string])
+
+const DEFAULT_CAPACITY = 8
+
+func NewDeque[T comparable](data ...T) *Deque[T] {
+	capacity := int32(DEFAULT_CAPACITY)
+	for capacity < int32(len(data)) {
+		capacity *= 2
+	}
+	d := &Deque[T]{array: make([]T, capacity)}
+	d.length = int32(copy(d.array, data))
+	return d
+}
+
+// NewRingBuffer returns a deque that holds at most capacity elements and
+// overwrites the oldest when full.
+func NewRingBuffer[T comparable](capacity int32) (*Deque[T], error) {
+	if capacity < 1 {
+		return nil, fmt.Errorf("ring buffer capacity must be positive")
+	}
+	return &Deque[T]{array: make([]T, capacity), fixed: true}, nil
+}
+
+// slot maps an index in the sequence to its slot in the array.
+func (d *Deque[T]) slot(index int32) int32 {
+	s := d.head + index
+	if s >= int32(len(d.array)) {
+		s -= int32(len(d.array))
+	}
+	return s
+}
+
+func (d *Deque[T]) GetData(index int32) (T, error) {
+	if index < 0 || index >= d.length {
+		return *new(T), fmt.Errorf("index out of range")
+	}
+	return d.array[d.slot(index)], nil
+}
+
+func (d *Deque[T]) Contains(data T) bool {
+	for i := int32(0); i < d.length; i++ {
+		if d.array[d.slot(i)] == data {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Deque[T]) Set(index int32, data T) error {
+	if index < 0 || index >= d.length {
+		return fmt.Errorf("index out of range")
+	}
+	d.array[d.slot(index)] = data
+	return nil
+}
+
+// Insert puts data at index, which may be GetSize to append. A full ring
+// buffer only accepts inserts at its ends.
+func (d *Deque[T]) Insert(index int32, data T) error {
+	if index < 0 || index > d.length {
+		return fmt.Errorf("index out of range")
+	}
+	if d.IsFull() {
+		switch {
+		case !d.fixed:
+			d.resize(max(2*int32(len(d.array)), DEFAULT_CAPACITY))
+		case index == 0:
+			d.drop(d.length - 1)
+		case index == d.length:
+			d.drop(0)
+			index--
+		default:
+			return fmt.Errorf("ring buffer is full")
+		}
+	}
+
+	if index < d.length/2 {
+		d.head--
+		if d.head < 0 {
+			d.head += int32(len(d.array))
+		}
+		for i := int32(0); i < index; i++ {
+			d.array[d.slot(i)] = d.array[d.slot(i+1)]
+		}
+	} else {
+		for i := d.length; i > index; i-- {
+			d.array[d.slot(i)] = d.array[d.slot(i-1)]
+		}
+	}
+	d.array[d.slot(index)] = data
+	d.length++
+	d.version++
+	return nil
+}
+
+func (d *Deque[T]) InsertFirst(data T) error {
+	return d.Insert(0, data)
+}
+
+func (d *Deque[T]) InsertLast(data T) error {
+	return d.Insert(d.length, data)
+}
+
+func (d *Deque[T]) Delete(index int32) error {
+	if d.IsEmpty() {
+		return fmt.Errorf("cannot remove from empty deque")
+	}
+	if index < 0 || index >= d.length {
+		return fmt.Errorf("index out of range")
+	}
+
+	d.drop(index)
+	d.version++
+	if !d.fixed && len(d.array) > DEFAULT_CAPACITY && d.length <= int32(len(d.array))/4 {
+		d.resize(int32(len(d.array)) / 2)
+	}
+	return nil
+}
+
+// drop removes the element at index without resizing.
+func (d *Deque[T]) drop(index int32) {
+	if index < d.length/2 {
+		for i := index; i > 0; i-- {
+			d.array[d.slot(i)] = d.array[d.slot(i-1)]
+		}
+		d.array[d.head] = *new(T)
+		d.head = d.slot(1)
+	} else {
+		for i := index; i < d.length-1; i++ {
+			d.array[d.slot(i)] = d.array[d.slot(i+1)]
+		}
+		d.array[d.slot(d.length-1)] = *new(T)
+	}
+	d.length--
+}
+
+// resize moves the elements to the front of a new array.
+func (d *Deque[T]) resize(capacity int32) {
+	array := make([]T, capacity)
+	for i := int32(0); i < d.length; i++ {
+		array[i] = d.array[d.slot(i)]
+	}
+	d.array = array
+	d.head = 0
+}
+
+func (d *Deque[T]) DeleteFirst() error {
+	return d.Delete(0)
+}
+
+func (d *Deque[T]) DeleteLast() error {
+	return d.Delete(d.length - 1)
+}
+
+func (d *Deque[T]) First() (T, error) {
+	if d.IsEmpty() {
+		return *new(T), fmt.Errorf("deque is empty")
+	}
+	return d.array[d.head], nil
+}
+
+func (d *Deque[T]) Last() (T, error) {
+	if d.IsEmpty() {
+		return *new(T), fmt.Errorf("deque is empty")
+	}
+	return d.array[d.slot(d.length-1)], nil
+}
+
+// PopFirst removes and returns the first element.
+func (d *Deque[T]) PopFirst() (T, error) {
+	first, err := d.First()
+	if err != nil {
+		return first, err
+	}
+	return first, d.DeleteFirst()
+}
+
+// PopLast removes and returns the last element.
+func (d *Deque[T]) PopLast() (T, error) {
+	last, err := d.Last()
+	if err != nil {
+		return last, err
+	}
+	return last, d.DeleteLast()
+}
+
+func (d *Deque[T]) GetSize() int32 {
+	return d.length
+}
+
+func (d *Deque[T]) Capacity() int32 {
+	return int32(len(d.array))
+}
+
+func (d *Deque[T]) IsEmpty() bool {
+	return d.length == 0
+}
+
+func (d *Deque[T]) IsFull() bool {
+	return d.length == int32(len(d.array))
+}
@@ -0,0 +1,162 @@
+package deque
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Deque(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name string
+		want func()
+	}{
+		{
+			name: "both ends",
+			want: func() {
+				d := NewDeque[int]()
+				for i := 1; i <= 3; i++ {
+					is.Nil(d.InsertLast(i))
+					is.Nil(d.InsertFirst(-i))
+				}
+				is.Equal([]int{-3, -2, -1, 1, 2, 3}, slices.Collect(d.Values()))
+
+				v, err := d.PopFirst()
+				is.Nil(err)
+				is.Equal(-3, v)
+				v, err = d.PopLast()
+				is.Nil(err)
+				is.Equal(3, v)
+				first, _ := d.First()
+				last, _ := d.Last()
+				is.Equal(-2, first)
+				is.Equal(2, last)
+				is.EqualValues(4, d.GetSize())
+			},
+		},
+		{
+			name: "middle inserts and deletes",
+			want: func() {
+				d := NewDeque(1, 2, 4, 5)
+				is.Nil(d.Insert(2, 3))
+				is.Nil(d.Insert(1, 9))
+				is.Equal([]int{1, 9, 2, 3, 4, 5}, slices.Collect(d.Values()))
+				is.Nil(d.Delete(1))
+				is.Nil(d.Delete(3))
+				is.Equal([]int{1, 2, 3, 5}, slices.Collect(d.Values()))
+				is.True(d.Contains(5))
+				is.False(d.Contains(4))
+			},
+		},
+		{
+			name: "errors",
+			want: func() {
+				d := NewDeque[int]()
+				is.Error(d.DeleteFirst())
+				is.Error(d.Insert(1, 1))
+				is.Error(d.Set(0, 1))
+				_, err := d.GetData(0)
+				is.Error(err)
+				_, err = d.PopLast()
+				is.Error(err)
+				_, err = NewRingBuffer[int](0)
+				is.Error(err)
+			},
+		},
+		{
+			name: "grows and shrinks",
+			want: func() {
+				d := NewDeque[int]()
+				for i := 0; i < 100; i++ {
+					is.Nil(d.InsertFirst(i))
+				}
+				is.EqualValues(128, d.Capacity())
+				for i := 0; i < 95; i++ {
+					is.Nil(d.DeleteLast())
+				}
+				is.EqualValues(16, d.Capacity())
+				is.Equal([]int{99, 98, 97, 96, 95}, slices.Collect(d.Values()))
+			},
+		},
+		{
+			name: "ring buffer keeps the newest",
+			want: func() {
+				d, err := NewRingBuffer[int](3)
+				is.Nil(err)
+				for i := 1; i <= 5; i++ {
+					is.Nil(d.InsertLast(i))
+				}
+				is.True(d.IsFull())
+				is.EqualValues(3, d.Capacity())
+				is.Equal([]int{3, 4, 5}, slices.Collect(d.Values()))
+
+				// Inserting at the front overwrites the back.
+				is.Nil(d.InsertFirst(0))
+				is.Equal([]int{0, 3, 4}, slices.Collect(d.Values()))
+				is.Error(d.Insert(1, 9))
+
+				is.Nil(d.DeleteFirst())
+				is.Nil(d.Insert(1, 9))
+				is.Equal([]int{3, 9, 4}, slices.Collect(d.Values()))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want()
+		})
+	}
+}
+
+// Test_Random checks a deque and a ring buffer against a slice.
+func Test_Random(t *testing.T) {
+	is := assert.New(t)
+	r := rand.New(rand.NewSource(47))
+
+	for _, capacity := range []int32{0, 1, 5} {
+		d := NewDeque[int]()
+		if capacity > 0 {
+			d, _ = NewRingBuffer[int](capacity)
+		}
+		var model []int
+
+		for i := 1; i <= 2000; i++ {
+			switch op := r.Intn(6); {
+			case op == 0 && len(model) > 0:
+				at := r.Intn(len(model))
+				is.Nil(d.Delete(int32(at)))
+				model = slices.Delete(model, at, at+1)
+			case op == 1 && len(model) > 0:
+				at := r.Intn(len(model))
+				is.Nil(d.Set(int32(at), -i))
+				model[at] = -i
+			case op == 2:
+				is.Nil(d.InsertFirst(i))
+				if capacity > 0 && len(model) == int(capacity) {
+					model = model[:len(model)-1]
+				}
+				model = slices.Insert(model, 0, i)
+			case op == 3:
+				is.Nil(d.InsertLast(i))
+				if capacity > 0 && len(model) == int(capacity) {
+					model = model[1:]
+				}
+				model = append(model, i)
+			case capacity == 0 || len(model) < int(capacity):
+				at := r.Intn(len(model) + 1)
+				is.Nil(d.Insert(int32(at), i))
+				model = slices.Insert(model, at, i)
+			}
+
+			is.EqualValues(len(model), d.GetSize())
+			for j, v := range d.All() {
+				is.Equal(model[j], v)
+			}
+		}
+	}
+}
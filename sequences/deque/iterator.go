@@ -0,0 +1,37 @@
+package deque
+
+import (
+	"iter"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+)
+
+// Iterator visits the deque's elements by index. It is fail-fast: once
+// an insert or delete changes the elements, Next returns false and Err reports
+// sequences.ErrConcurrentModification. Elements overwritten with Set are seen
+// by the iterator.
+func (d *Deque[T]) Iterator() sequences.Iterator[T] {
+	return sequences.NewIndexIterator(d.at, d.GetSize, &d.version, false)
+}
+
+// ReverseIterator is Iterator from the last element to the first.
+func (d *Deque[T]) ReverseIterator() sequences.Iterator[T] {
+	return sequences.NewIndexIterator(d.at, d.GetSize, &d.version, true)
+}
+
+// at returns element i, which must be in range.
+func (d *Deque[T]) at(i int32) T {
+	return d.array[d.slot(i)]
+}
+
+func (d *Deque[T]) All() iter.Seq2[int32, T] {
+	return sequences.All(d.Iterator)
+}
+
+func (d *Deque[T]) Backward() iter.Seq2[int32, T] {
+	return sequences.All(d.ReverseIterator)
+}
+
+func (d *Deque[T]) Values() iter.Seq[T] {
+	return sequences.Values(d.Iterator)
+}
@@ -0,0 +1,20 @@
+package deque
+
+import (
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+	"github.com/OladapoAjala/datastructures/sequences/sequencetest"
+)
+
+func Test_Sequencer(t *testing.T) {
+	sequencetest.Run(t, func() sequences.Sequencer[int] {
+		return NewDeque[int]()
+	})
+}
+
+func Test_ZeroValue(t *testing.T) {
+	sequencetest.Run(t, func() sequences.Sequencer[int] {
+		return new(Deque[int])
+	})
+}
@@ -0,0 +1,103 @@
+package dynamicarray
+
+import "fmt"
+
+// FromSlice returns an array holding a copy of data, with no spare
+// capacity.
+func FromSlice[T comparable](data []T) *DynamicArray[T] {
+	da := &DynamicArray[T]{length: int32(len(data))}
+	da.array = make([]T, max(len(data), 2))
+	da.capacity = int32(len(da.array))
+	copy(da.array, data)
+	return da
+}
+
+// ToSlice returns a copy of the elements.
+func (da *DynamicArray[T]) ToSlice() []T {
+	return append([]T(nil), da.array[:da.length]...)
+}
+
+func (da *DynamicArray[T]) Clone() *DynamicArray[T] {
+	clone := &DynamicArray[T]{
+		array:    make([]T, da.capacity),
+		length:   da.length,
+		capacity: da.capacity,
+	}
+	copy(clone.array, da.array[:da.length])
+	return clone
+}
+
+// AppendAll adds data at the end with at most one reallocation.
+func (da *DynamicArray[T]) AppendAll(data ...T) error {
+	return da.InsertAll(da.length, data...)
+}
+
+// InsertAll puts data at index, shifting the elements after it once, in
+// O(n+k) rather than the O(n*k) of k calls to Insert. index may be GetSize
+// to append.
+func (da *DynamicArray[T]) InsertAll(index int32, data ...T) error {
+	if index < 0 || index > da.length {
+		return fmt.Errorf("index out of range")
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	k := int32(len(data))
+	if da.length+k > da.capacity {
+		da.Reserve(max(2*da.capacity, da.length+k))
+	}
+	copy(da.array[index+k:], da.array[index:da.length])
+	copy(da.array[index:], data)
+	da.length += k
+	da.version++
+	return nil
+}
+
+// DeleteRange removes the elements from index i up to, but not including,
+// index j, shifting the rest down once. Like Delete it halves the capacity
+// while the array is at most a quarter full.
+func (da *DynamicArray[T]) DeleteRange(i, j int32) error {
+	if i < 0 || j > da.length || i > j {
+		return fmt.Errorf("invalid range [%d, %d)", i, j)
+	}
+	if i == j {
+		return nil
+	}
+
+	copy(da.array[i:], da.array[j:da.length])
+	clear(da.array[da.length-(j-i) : da.length])
+	da.length -= j - i
+	da.version++
+
+	capacity := da.capacity
+	for capacity > 2 && da.length <= capacity/4 {
+		capacity /= 2
+	}
+	if capacity != da.capacity {
+		da.resize(capacity)
+	}
+	return nil
+}
+
+// Reserve grows the capacity to at least n, so the next n-GetSize appends
+// do not reallocate.
+func (da *DynamicArray[T]) Reserve(n int32) {
+	if n > da.capacity {
+		da.resize(n)
+	}
+}
+
+// ShrinkToFit drops the spare capacity, keeping the minimum of 2.
+func (da *DynamicArray[T]) ShrinkToFit() {
+	if capacity := max(da.length, 2); capacity != da.capacity {
+		da.resize(capacity)
+	}
+}
+
+func (da *DynamicArray[T]) resize(capacity int32) {
+	array := make([]T, capacity)
+	copy(array, da.array[:da.length])
+	da.array = array
+	da.capacity = capacity
+}
@@ -0,0 +1,118 @@
+package dynamicarray
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Bulk(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name string
+		want func()
+	}{
+		{
+			name: "slice round trip",
+			want: func() {
+				data := []int{1, 2, 3}
+				da := FromSlice(data)
+				data[0] = 9
+				is.Equal([]int{1, 2, 3}, da.ToSlice())
+				is.EqualValues(3, da.Capacity())
+
+				out := da.ToSlice()
+				out[0] = 9
+				v, _ := da.GetData(0)
+				is.Equal(1, v)
+
+				is.EqualValues(2, FromSlice([]int{}).Capacity())
+				is.Empty(FromSlice([]int{}).ToSlice())
+			},
+		},
+		{
+			name: "clone is independent",
+			want: func() {
+				da := FromSlice([]int{1, 2, 3})
+				clone := da.Clone()
+				is.Nil(clone.Set(0, 9))
+				is.Nil(clone.InsertLast(4))
+				is.Equal([]int{1, 2, 3}, da.ToSlice())
+				is.Equal([]int{9, 2, 3, 4}, clone.ToSlice())
+			},
+		},
+		{
+			name: "append all reallocates once",
+			want: func() {
+				da := NewDynamicArray(1)
+				is.Nil(da.AppendAll(2, 3, 4, 5, 6))
+				is.Equal([]int{1, 2, 3, 4, 5, 6}, da.ToSlice())
+				is.EqualValues(6, da.Capacity())
+				is.Nil(da.AppendAll())
+				is.Nil(da.AppendAll(7))
+				is.EqualValues(12, da.Capacity())
+			},
+		},
+		{
+			name: "insert all",
+			want: func() {
+				da := FromSlice([]int{1, 5})
+				is.Nil(da.InsertAll(1, 2, 3, 4))
+				is.Equal([]int{1, 2, 3, 4, 5}, da.ToSlice())
+				is.Nil(da.InsertAll(0, -1, 0))
+				is.Equal([]int{-1, 0, 1, 2, 3, 4, 5}, da.ToSlice())
+				is.Error(da.InsertAll(8, 1))
+				is.Error(da.InsertAll(-1, 1))
+			},
+		},
+		{
+			name: "delete range",
+			want: func() {
+				da := FromSlice([]int{0, 1, 2, 3, 4, 5, 6, 7})
+				is.Nil(da.DeleteRange(2, 5))
+				is.Equal([]int{0, 1, 5, 6, 7}, da.ToSlice())
+				is.Nil(da.DeleteRange(3, 3))
+				is.Error(da.DeleteRange(3, 2))
+				is.Error(da.DeleteRange(0, 6))
+
+				is.Nil(da.DeleteRange(0, 4))
+				is.Equal([]int{7}, da.ToSlice())
+				is.EqualValues(2, da.Capacity())
+			},
+		},
+		{
+			name: "reserve and shrink to fit",
+			want: func() {
+				da := FromSlice([]int{1, 2, 3})
+				da.Reserve(100)
+				is.EqualValues(100, da.Capacity())
+				da.Reserve(10)
+				is.EqualValues(100, da.Capacity())
+				is.Equal([]int{1, 2, 3}, da.ToSlice())
+
+				da.ShrinkToFit()
+				is.EqualValues(3, da.Capacity())
+				is.Equal([]int{1, 2, 3}, da.ToSlice())
+			},
+		},
+		{
+			name: "bulk changes fail iterators fast",
+			want: func() {
+				da := FromSlice([]int{1, 2, 3})
+				it := da.Iterator()
+				da.Reserve(10)
+				is.True(it.Next())
+				is.Nil(da.AppendAll(4))
+				is.False(it.Next())
+				is.Error(it.Err())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want()
+		})
+	}
+}
@@ -11,12 +11,21 @@ type DynamicArray[T comparable] struct {
 	array    []T
 	length   int32
 	capacity int32
+	// version counts structural changes, for fail-fast iteration.
+	version uint64
 }
 
 type IDynamicArray[T comparable] interface {
 	sequences.Sequencer[T]
 	ToLinkedList() (*linkedlist.LinkedList[T], error)
 	Capacity() int32
+	ToSlice() []T
+	Clone() *DynamicArray[T]
+	AppendAll(...T) error
+	InsertAll(int32, ...T) error
+	DeleteRange(int32, int32) error
+	Reserve(int32)
+	ShrinkToFit()
 }
 
 var _ IDynamicArray[string] = new(DynamicArray[string])
@@ -73,12 +82,13 @@ func (da *DynamicArray[T]) Set(index int32, data T) error {
 	da.capacity = int32(len(da.array))
 	if index >= da.length {
 		da.length = index + 1
+		da.version++
 	}
 	return nil
 }
 
 func (da *DynamicArray[T]) Insert(index int32, data T) error {
-	if index >= da.capacity || (index+1) >= da.capacity {
+	if index+1 >= da.capacity || da.length >= da.capacity {
 		newArray := make([]T, 2*da.capacity)
 		copy(newArray, da.array)
 		da.array = newArray
@@ -99,7 +109,10 @@ func (da *DynamicArray[T]) Insert(index int32, data T) error {
 	da.capacity = int32(len(newArray))
 	if index >= da.length {
 		da.length = index + 1
+	} else {
+		da.length++
 	}
+	da.version++
 	return nil
 }
 
@@ -125,6 +138,7 @@ func (da *DynamicArray[T]) Delete(index int32) error {
 
 	da.shift(index)
 	da.length--
+	da.version++
 
 	if da.GetSize() <= da.Capacity()/4 {
 		if da.Capacity() == 2 {
@@ -141,9 +155,10 @@ func (da *DynamicArray[T]) Delete(index int32) error {
 }
 
 func (da *DynamicArray[T]) shift(index int32) {
-	for i := index; i < da.GetSize(); i++ {
+	for i := index; i < da.GetSize()-1; i++ {
 		da.array[i] = da.array[i+1]
 	}
+	da.array[da.GetSize()-1] = *new(T)
 }
 
 func (da *DynamicArray[T]) DeleteFirst() error {
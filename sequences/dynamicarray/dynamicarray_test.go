@@ -146,7 +146,7 @@ func Test_Set(t *testing.T) {
 			want: func(da *DynamicArray[string], err error) {
 				is.Nil(err)
 				is.False(da.Contains("b"))
-				is.Equal(da, NewDynamicArray[string]("a", "d", "c"))
+				equal(is, NewDynamicArray[string]("a", "d", "c"), da)
 			},
 		},
 		{
@@ -209,6 +209,19 @@ func Test_Insert(t *testing.T) {
 				is.Nil(err)
 				is.True(da.Contains("b"))
 				is.Equal(da.array, []string{"a", "d", "b", "c", "", ""})
+				is.EqualValues(4, da.GetSize())
+			},
+		},
+		{
+			name:         "insert data in full dynamicarray",
+			dynamicarray: full("a", "b", "c", "d"),
+			args: args{
+				index: 1,
+				data:  "x",
+			},
+			want: func(da *DynamicArray[string], err error) {
+				is.Nil(err)
+				is.Equal([]string{"a", "x", "b", "c", "d"}, da.ToSlice())
 			},
 		},
 		{
@@ -376,6 +389,17 @@ func Test_Delete(t *testing.T) {
 				is.Empty(val)
 			},
 		},
+		{
+			name:         "delete from full dynamicarray",
+			dynamicarray: full("a", "b", "c", "d"),
+			args: args{
+				index: 1,
+			},
+			want: func(da *DynamicArray[string], err error) {
+				is.Nil(err)
+				is.Equal([]string{"a", "c", "d"}, da.ToSlice())
+			},
+		},
 		{
 			name:         "delete from invalid index",
 			dynamicarray: NewDynamicArray[string]("a", "b", "c"),
@@ -397,7 +421,7 @@ func Test_Delete(t *testing.T) {
 			want: func(da *DynamicArray[string], err error) {
 				is.Nil(err)
 				is.False(da.Contains("x"))
-				is.Equal(da, NewDynamicArray[string]())
+				equal(is, NewDynamicArray[string](), da)
 			},
 		},
 		{
@@ -414,7 +438,7 @@ func Test_Delete(t *testing.T) {
 					err = da.Delete(0)
 					is.Nil(err)
 				}
-				is.Equal(da, NewDynamicArray[string]("e", "f", "g", "h"))
+				equal(is, NewDynamicArray[string]("e", "f", "g", "h"), da)
 			},
 		},
 	}
@@ -426,3 +450,18 @@ func Test_Delete(t *testing.T) {
 		})
 	}
 }
+
+// equal compares the contents of two arrays, ignoring how many changes each
+// has seen.
+func equal(is *assert.Assertions, want, got *DynamicArray[string]) {
+	is.Equal(want.array, got.array)
+	is.Equal(want.length, got.length)
+	is.Equal(want.capacity, got.capacity)
+}
+
+// full returns an array holding data with no spare capacity.
+func full(data ...string) *DynamicArray[string] {
+	da := NewDynamicArray(data...)
+	da.ShrinkToFit()
+	return da
+}
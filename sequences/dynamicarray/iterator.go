@@ -0,0 +1,37 @@
+package dynamicarray
+
+import (
+	"iter"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+)
+
+// Iterator visits the array's elements by index. It is fail-fast: once
+// an insert or delete shifts the elements, Next returns false and Err reports
+// sequences.ErrConcurrentModification. Elements overwritten with Set are seen
+// by the iterator.
+func (da *DynamicArray[T]) Iterator() sequences.Iterator[T] {
+	return sequences.NewIndexIterator(da.at, da.GetSize, &da.version, false)
+}
+
+// ReverseIterator is Iterator from the last element to the first.
+func (da *DynamicArray[T]) ReverseIterator() sequences.Iterator[T] {
+	return sequences.NewIndexIterator(da.at, da.GetSize, &da.version, true)
+}
+
+// at returns element i, which must be in range.
+func (da *DynamicArray[T]) at(i int32) T {
+	return da.array[i]
+}
+
+func (da *DynamicArray[T]) All() iter.Seq2[int32, T] {
+	return sequences.All(da.Iterator)
+}
+
+func (da *DynamicArray[T]) Backward() iter.Seq2[int32, T] {
+	return sequences.All(da.ReverseIterator)
+}
+
+func (da *DynamicArray[T]) Values() iter.Seq[T] {
+	return sequences.Values(da.Iterator)
+}
@@ -0,0 +1,14 @@
+package dynamicarray
+
+import (
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+	"github.com/OladapoAjala/datastructures/sequences/sequencetest"
+)
+
+func Test_Iterator(t *testing.T) {
+	sequencetest.Iterate(t, func(values ...int) sequences.Sequencer[int] {
+		return NewDynamicArray(values...)
+	})
+}
@@ -0,0 +1,213 @@
+package gapbuffer
+
+import (
+	"fmt"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+)
+
+// GapBuffer keeps its elements in an array with a gap of free slots at the
+// cursor: the elements before the cursor are array[:gapStart] and the rest
+// are array[gapEnd:]. Inserting or deleting at the cursor is O(1); moving
+// the cursor costs the distance moved. Edits that stay near one place, as
+// typing does, are cheap however large the buffer. Insert, Delete and the
+// other indexed edits move the cursor to their index first.
+type GapBuffer[T comparable] struct {
+	array    []T
+	gapStart int32
+	gapEnd   int32
+	// version counts structural changes, for fail-fast iteration.
+	version uint64
+}
+
+type IGapBuffer[T comparable] interface {
+	sequences.Sequencer[T]
+	GetCursor() int32
+	SetCursor(int32) error
+	InsertAtCursor(T) error
+	DeleteBeforeCursor() error
+	DeleteAfterCursor() error
+	Capacity() int32
+}
+
+var _ IGapBuffer[string] = new(GapBuffer[string])
+
+const DEFAULT_CAPACITY = 16
+
+// NewGapBuffer returns a buffer holding data with the cursor at the end.
+func NewGapBuffer[T comparable](data ...T) *GapBuffer[T] {
+	capacity := int32(DEFAULT_CAPACITY)
+	for capacity < int32(len(data))+1 {
+		capacity *= 2
+	}
+	gb := &GapBuffer[T]{array: make([]T, capacity)}
+	gb.gapStart = int32(copy(gb.array, data))
+	gb.gapEnd = capacity
+	return gb
+}
+
+// slot maps an index in the sequence to its slot in the array, skipping
+// the gap.
+func (gb *GapBuffer[T]) slot(index int32) int32 {
+	if index < gb.gapStart {
+		return index
+	}
+	return index + gb.gapEnd - gb.gapStart
+}
+
+func (gb *GapBuffer[T]) GetData(index int32) (T, error) {
+	if index < 0 || index >= gb.GetSize() {
+		return *new(T), fmt.Errorf("index out of range")
+	}
+	return gb.array[gb.slot(index)], nil
+}
+
+func (gb *GapBuffer[T]) Contains(data T) bool {
+	for i := int32(0); i < gb.GetSize(); i++ {
+		if gb.array[gb.slot(i)] == data {
+			return true
+		}
+	}
+	return false
+}
+
+func (gb *GapBuffer[T]) Set(index int32, data T) error {
+	if index < 0 || index >= gb.GetSize() {
+		return fmt.Errorf("index out of range")
+	}
+	gb.array[gb.slot(index)] = data
+	return nil
+}
+
+func (gb *GapBuffer[T]) GetCursor() int32 {
+	return gb.gapStart
+}
+
+// SetCursor moves the gap to index, which may be GetSize, by shifting the
+// elements between the old and new positions across it. The slots left
+// behind are zeroed so the gap holds no stale references.
+func (gb *GapBuffer[T]) SetCursor(index int32) error {
+	if index < 0 || index > gb.GetSize() {
+		return fmt.Errorf("index out of range")
+	}
+
+	if index < gb.gapStart {
+		n := gb.gapStart - index
+		copy(gb.array[gb.gapEnd-n:gb.gapEnd], gb.array[index:gb.gapStart])
+		clear(gb.array[index : gb.gapEnd-n])
+		gb.gapStart -= n
+		gb.gapEnd -= n
+	} else if index > gb.gapStart {
+		n := index - gb.gapStart
+		copy(gb.array[gb.gapStart:], gb.array[gb.gapEnd:gb.gapEnd+n])
+		clear(gb.array[gb.gapStart+n : gb.gapEnd+n])
+		gb.gapStart += n
+		gb.gapEnd += n
+	}
+	return nil
+}
+
+// InsertAtCursor puts data before the cursor and advances the cursor past
+// it, like typing a character.
+func (gb *GapBuffer[T]) InsertAtCursor(data T) error {
+	if gb.gapStart == gb.gapEnd {
+		gb.resize(max(2*int32(len(gb.array)), DEFAULT_CAPACITY))
+	}
+	gb.array[gb.gapStart] = data
+	gb.gapStart++
+	gb.version++
+	return nil
+}
+
+// DeleteBeforeCursor removes the element before the cursor, like
+// backspace.
+func (gb *GapBuffer[T]) DeleteBeforeCursor() error {
+	if gb.gapStart == 0 {
+		return fmt.Errorf("nothing before cursor")
+	}
+	gb.gapStart--
+	gb.array[gb.gapStart] = *new(T)
+	gb.deleted()
+	return nil
+}
+
+// DeleteAfterCursor removes the element after the cursor, like delete.
+func (gb *GapBuffer[T]) DeleteAfterCursor() error {
+	if gb.gapEnd == int32(len(gb.array)) {
+		return fmt.Errorf("nothing after cursor")
+	}
+	gb.array[gb.gapEnd] = *new(T)
+	gb.gapEnd++
+	gb.deleted()
+	return nil
+}
+
+// deleted halves the array once it is a quarter full.
+func (gb *GapBuffer[T]) deleted() {
+	gb.version++
+	if len(gb.array) > DEFAULT_CAPACITY && gb.GetSize() <= int32(len(gb.array))/4 {
+		gb.resize(int32(len(gb.array)) / 2)
+	}
+}
+
+// resize copies the elements into a new array, keeping the cursor where it
+// is and giving the gap all the free slots.
+func (gb *GapBuffer[T]) resize(capacity int32) {
+	array := make([]T, capacity)
+	copy(array, gb.array[:gb.gapStart])
+	after := int32(len(gb.array)) - gb.gapEnd
+	copy(array[capacity-after:], gb.array[gb.gapEnd:])
+	gb.array = array
+	gb.gapEnd = capacity - after
+}
+
+// Insert moves the cursor to index and inserts there, leaving the cursor
+// after the new element.
+func (gb *GapBuffer[T]) Insert(index int32, data T) error {
+	if err := gb.SetCursor(index); err != nil {
+		return err
+	}
+	return gb.InsertAtCursor(data)
+}
+
+func (gb *GapBuffer[T]) InsertFirst(data T) error {
+	return gb.Insert(0, data)
+}
+
+func (gb *GapBuffer[T]) InsertLast(data T) error {
+	return gb.Insert(gb.GetSize(), data)
+}
+
+// Delete moves the cursor to index and deletes the element after it.
+func (gb *GapBuffer[T]) Delete(index int32) error {
+	if gb.IsEmpty() {
+		return fmt.Errorf("cannot remove from empty buffer")
+	}
+	if index < 0 || index >= gb.GetSize() {
+		return fmt.Errorf("index out of range")
+	}
+	if err := gb.SetCursor(index); err != nil {
+		return err
+	}
+	return gb.DeleteAfterCursor()
+}
+
+func (gb *GapBuffer[T]) DeleteFirst() error {
+	return gb.Delete(0)
+}
+
+func (gb *GapBuffer[T]) DeleteLast() error {
+	return gb.Delete(gb.GetSize() - 1)
+}
+
+func (gb *GapBuffer[T]) GetSize() int32 {
+	return int32(len(gb.array)) - (gb.gapEnd - gb.gapStart)
+}
+
+func (gb *GapBuffer[T]) Capacity() int32 {
+	return int32(len(gb.array))
+}
+
+func (gb *GapBuffer[T]) IsEmpty() bool {
+	return gb.GetSize() == 0
+}
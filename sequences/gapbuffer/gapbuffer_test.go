@@ -0,0 +1,104 @@
+package gapbuffer
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Cursor(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name string
+		want func()
+	}{
+		{
+			name: "typing",
+			want: func() {
+				gb := NewGapBuffer([]rune("helo")...)
+				is.EqualValues(4, gb.GetCursor())
+				is.Nil(gb.SetCursor(3))
+				is.Nil(gb.InsertAtCursor('l'))
+				is.EqualValues(4, gb.GetCursor())
+				is.Equal("hello", string(slices.Collect(gb.Values())))
+
+				is.Nil(gb.SetCursor(5))
+				for _, r := range " world" {
+					is.Nil(gb.InsertAtCursor(r))
+				}
+				is.Equal("hello world", string(slices.Collect(gb.Values())))
+			},
+		},
+		{
+			name: "backspace and delete",
+			want: func() {
+				gb := NewGapBuffer([]rune("abcdef")...)
+				is.Nil(gb.SetCursor(3))
+				is.Nil(gb.DeleteBeforeCursor())
+				is.Nil(gb.DeleteAfterCursor())
+				is.EqualValues(2, gb.GetCursor())
+				is.Equal("abef", string(slices.Collect(gb.Values())))
+
+				is.Nil(gb.SetCursor(0))
+				is.Error(gb.DeleteBeforeCursor())
+				is.Nil(gb.SetCursor(gb.GetSize()))
+				is.Error(gb.DeleteAfterCursor())
+				is.Error(gb.SetCursor(5))
+			},
+		},
+		{
+			name: "indexed edits move the cursor",
+			want: func() {
+				gb := NewGapBuffer(1, 2, 3)
+				is.Nil(gb.Insert(1, 9))
+				is.EqualValues(2, gb.GetCursor())
+				is.Nil(gb.Delete(0))
+				is.EqualValues(0, gb.GetCursor())
+				is.Equal([]int{9, 2, 3}, slices.Collect(gb.Values()))
+			},
+		},
+		{
+			name: "gap is cleared as it moves",
+			want: func() {
+				gb := NewGapBuffer(1, 2, 3, 4, 5)
+				is.Nil(gb.SetCursor(1))
+				is.Nil(gb.SetCursor(4))
+				is.Nil(gb.SetCursor(0))
+				for i := gb.gapStart; i < gb.gapEnd; i++ {
+					is.Zero(gb.array[i])
+				}
+				is.Equal([]int{1, 2, 3, 4, 5}, slices.Collect(gb.Values()))
+			},
+		},
+		{
+			name: "grows and shrinks around the cursor",
+			want: func() {
+				gb := NewGapBuffer[int]()
+				var model []int
+				for i := 0; i < 100; i++ {
+					is.Nil(gb.InsertAtCursor(i))
+					model = append(model, i)
+				}
+				is.EqualValues(128, gb.Capacity())
+
+				is.Nil(gb.SetCursor(50))
+				for i := 0; i < 45; i++ {
+					is.Nil(gb.DeleteAfterCursor())
+					is.Nil(gb.DeleteBeforeCursor())
+				}
+				model = slices.Delete(model, 5, 95)
+				is.EqualValues(5, gb.GetCursor())
+				is.EqualValues(32, gb.Capacity())
+				is.Equal(model, slices.Collect(gb.Values()))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want()
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package gapbuffer
+
+import (
+	"iter"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+)
+
+// Iterator visits the buffer's elements by index. It is fail-fast: once
+// an insert or delete changes the elements, Next returns false and Err reports
+// sequences.ErrConcurrentModification. Elements overwritten with Set are seen
+// by the iterator.
+func (gb *GapBuffer[T]) Iterator() sequences.Iterator[T] {
+	return sequences.NewIndexIterator(gb.at, gb.GetSize, &gb.version, false)
+}
+
+// ReverseIterator is Iterator from the last element to the first.
+func (gb *GapBuffer[T]) ReverseIterator() sequences.Iterator[T] {
+	return sequences.NewIndexIterator(gb.at, gb.GetSize, &gb.version, true)
+}
+
+// at returns element i, which must be in range.
+func (gb *GapBuffer[T]) at(i int32) T {
+	return gb.array[gb.slot(i)]
+}
+
+func (gb *GapBuffer[T]) All() iter.Seq2[int32, T] {
+	return sequences.All(gb.Iterator)
+}
+
+func (gb *GapBuffer[T]) Backward() iter.Seq2[int32, T] {
+	return sequences.All(gb.ReverseIterator)
+}
+
+func (gb *GapBuffer[T]) Values() iter.Seq[T] {
+	return sequences.Values(gb.Iterator)
+}
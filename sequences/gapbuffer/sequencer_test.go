@@ -0,0 +1,20 @@
+package gapbuffer
+
+import (
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+	"github.com/OladapoAjala/datastructures/sequences/sequencetest"
+)
+
+func Test_Sequencer(t *testing.T) {
+	sequencetest.Run(t, func() sequences.Sequencer[int] {
+		return NewGapBuffer[int]()
+	})
+}
+
+func Test_ZeroValue(t *testing.T) {
+	sequencetest.Run(t, func() sequences.Sequencer[int] {
+		return new(GapBuffer[int])
+	})
+}
@@ -0,0 +1,187 @@
+package sequences
+
+import (
+	"errors"
+	"iter"
+)
+
+// ErrConcurrentModification is reported when a sequence gains, loses or
+// reorders elements while it is being iterated.
+var ErrConcurrentModification = errors.New("sequence modified during iteration")
+
+// Iterator walks a sequence in one direction. Next returns false when the
+// elements are exhausted or when the sequence was structurally modified
+// after the iterator was created; Err tells the two apart. Index is the
+// position of the current element in the sequence.
+type Iterator[T any] interface {
+	Next() bool
+	Index() int32
+	Value() T
+	Err() error
+}
+
+// Iterable is implemented by every Sequencer. All and Values run from the
+// first element to the last and Backward from the last to the first. They
+// are fail-fast: if the loop body structurally modifies the sequence, they
+// panic with ErrConcurrentModification. Overwriting an element with Set is
+// not a structural change.
+type Iterable[T any] interface {
+	Iterator() Iterator[T]
+	ReverseIterator() Iterator[T]
+	All() iter.Seq2[int32, T]
+	Backward() iter.Seq2[int32, T]
+	Values() iter.Seq[T]
+}
+
+// All adapts the iterators made by newIterator to a range-over-func
+// sequence of indices and elements. Each range loop gets a fresh iterator.
+func All[T any](newIterator func() Iterator[T]) iter.Seq2[int32, T] {
+	return func(yield func(int32, T) bool) {
+		it := newIterator()
+		for it.Next() {
+			if !yield(it.Index(), it.Value()) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Values is All without the indices.
+func Values[T any](newIterator func() Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range All(newIterator) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// IndexIterator visits a sequence with O(1) positional access by index,
+// forwards or backwards. It is fail-fast: once the version it was created
+// with changes, Next returns false and Err reports ErrConcurrentModification.
+// Elements are read when Value is called, so those overwritten with Set are
+// seen.
+type IndexIterator[T any] struct {
+	get     func(int32) T
+	length  func() int32
+	version *uint64
+	seen    uint64
+	index   int32
+	step    int32
+	err     error
+}
+
+var _ Iterator[string] = new(IndexIterator[string])
+
+// NewIndexIterator reads element i with get, which is only called for
+// indices below length(). version is the sequence's counter of structural
+// changes.
+func NewIndexIterator[T any](get func(int32) T, length func() int32, version *uint64, reverse bool) *IndexIterator[T] {
+	it := &IndexIterator[T]{get: get, length: length, version: version, seen: *version, index: -1, step: 1}
+	if reverse {
+		it.index, it.step = length(), -1
+	}
+	return it
+}
+
+func (it *IndexIterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.seen != *it.version {
+		it.err = ErrConcurrentModification
+		return false
+	}
+	// Clamp to one step past either end, so Index stays put once the
+	// iterator is exhausted.
+	it.index += it.step
+	if it.index < -1 {
+		it.index = -1
+	} else if length := it.length(); it.index > length {
+		it.index = length
+	}
+	return it.valid()
+}
+
+func (it *IndexIterator[T]) valid() bool {
+	return it.err == nil && it.index >= 0 && it.index < it.length()
+}
+
+func (it *IndexIterator[T]) Index() int32 {
+	return it.index
+}
+
+func (it *IndexIterator[T]) Value() T {
+	if !it.valid() {
+		return *new(T)
+	}
+	return it.get(it.index)
+}
+
+func (it *IndexIterator[T]) Err() error {
+	return it.err
+}
+
+// LinkIterator follows links from node to node, so a full pass over a linked
+// structure takes O(n) without positional access. It is fail-fast in the
+// same way as IndexIterator, and reads the current node's element when Value
+// is called.
+type LinkIterator[N comparable, T any] struct {
+	next     func(N) N
+	value    func(N) T
+	version  *uint64
+	seen     uint64
+	node     N
+	index    int32
+	step     int32
+	finished bool
+	err      error
+}
+
+var _ Iterator[string] = new(LinkIterator[*string, string])
+
+// NewLinkIterator walks the nodes returned by next, which is given the zero
+// N to find the first node and returns the zero N past the last one. length
+// is the number of nodes, used to number them when walking in reverse.
+func NewLinkIterator[N comparable, T any](next func(N) N, value func(N) T, length int32, version *uint64, reverse bool) *LinkIterator[N, T] {
+	it := &LinkIterator[N, T]{next: next, value: value, version: version, seen: *version, index: -1, step: 1}
+	if reverse {
+		it.index, it.step = length, -1
+	}
+	return it
+}
+
+func (it *LinkIterator[N, T]) Next() bool {
+	if it.err != nil || it.finished {
+		return false
+	}
+	if it.seen != *it.version {
+		it.node = *new(N)
+		it.err = ErrConcurrentModification
+		return false
+	}
+
+	it.node = it.next(it.node)
+	it.index += it.step
+	it.finished = it.node == *new(N)
+	return !it.finished
+}
+
+func (it *LinkIterator[N, T]) Index() int32 {
+	return it.index
+}
+
+func (it *LinkIterator[N, T]) Value() T {
+	if it.node == *new(N) {
+		return *new(T)
+	}
+	return it.value(it.node)
+}
+
+func (it *LinkIterator[N, T]) Err() error {
+	return it.err
+}
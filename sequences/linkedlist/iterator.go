@@ -0,0 +1,49 @@
+package linkedlist
+
+import (
+	"iter"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+	"github.com/OladapoAjala/datastructures/sequences/node"
+)
+
+// Iterator follows the list's links from the head. It is fail-fast: once a
+// node is inserted, removed or moved, Next returns false and Err reports
+// sequences.ErrConcurrentModification. Data overwritten in place is seen by
+// the iterator.
+func (l *LinkedList[T]) Iterator() sequences.Iterator[T] {
+	next := func(n *node.Node[T]) *node.Node[T] {
+		if n == nil {
+			return l.Head
+		}
+		return n.Next
+	}
+	return sequences.NewLinkIterator(next, nodeData[T], l.length, &l.version, false)
+}
+
+// ReverseIterator is Iterator following the links back from the tail.
+func (l *LinkedList[T]) ReverseIterator() sequences.Iterator[T] {
+	prev := func(n *node.Node[T]) *node.Node[T] {
+		if n == nil {
+			return l.Tail
+		}
+		return n.Prev
+	}
+	return sequences.NewLinkIterator(prev, nodeData[T], l.length, &l.version, true)
+}
+
+func nodeData[T comparable](n *node.Node[T]) T {
+	return n.Data
+}
+
+func (l *LinkedList[T]) All() iter.Seq2[int32, T] {
+	return sequences.All(l.Iterator)
+}
+
+func (l *LinkedList[T]) Backward() iter.Seq2[int32, T] {
+	return sequences.All(l.ReverseIterator)
+}
+
+func (l *LinkedList[T]) Values() iter.Seq[T] {
+	return sequences.Values(l.Iterator)
+}
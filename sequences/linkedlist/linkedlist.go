@@ -2,6 +2,7 @@ package linkedlist
 
 import (
 	"fmt"
+	"iter"
 
 	"github.com/OladapoAjala/datastructures/sequences"
 	"github.com/OladapoAjala/datastructures/sequences/node"
@@ -11,11 +12,17 @@ type LinkedList[T comparable] struct {
 	length int32
 	Head   *node.Node[T]
 	Tail   *node.Node[T]
+	// version counts structural changes, for fail-fast iteration.
+	version uint64
 }
 
 type ILinkedList[T comparable] interface {
 	sequences.Sequencer[T]
+	sequences.Assigner[T]
 	GetNode(int32) (*node.Node[T], error)
+	DeleteNode(*node.Node[T]) error
+	MoveFirst(*node.Node[T]) error
+	InsertAfter(*node.Node[T], T) error
 	ToArray() ([]T, error)
 	Reverse() error
 	Clear() error
@@ -67,6 +74,7 @@ func (l *LinkedList[T]) InsertFirst(data T) error {
 		newNode.Data = data
 		l.Head, l.Tail = newNode, newNode
 		l.length++
+		l.version++
 		return nil
 	}
 
@@ -78,6 +86,7 @@ func (l *LinkedList[T]) InsertFirst(data T) error {
 	l.Head.Prev = newNode
 	l.Head = newNode
 	l.length++
+	l.version++
 
 	return nil
 }
@@ -95,11 +104,12 @@ func (l *LinkedList[T]) InsertLast(data T) error {
 	l.Tail.Next = newNode
 	l.Tail = newNode
 	l.length++
+	l.version++
 	return nil
 }
 
 func (l *LinkedList[T]) Insert(index int32, data T) error {
-	if l.IsEmpty() {
+	if l.IsEmpty() || index == 0 {
 		return l.InsertFirst(data)
 	}
 
@@ -120,6 +130,7 @@ func (l *LinkedList[T]) Insert(index int32, data T) error {
 	oldNode.Prev.Next = newNode
 	oldNode.Prev = newNode
 	l.length++
+	l.version++
 	return nil
 }
 
@@ -136,6 +147,18 @@ func (l *LinkedList[T]) Set(index int32, data T) error {
 	return nil
 }
 
+// Assign overwrites the list's data from the head onwards with values.
+func (l *LinkedList[T]) Assign(values iter.Seq[T]) {
+	n := l.Head
+	for v := range values {
+		if n == nil {
+			return
+		}
+		n.Data = v
+		n = n.Next
+	}
+}
+
 func (l *LinkedList[T]) IsEmpty() bool {
 	return l.length == 0
 }
@@ -165,6 +188,7 @@ func (l *LinkedList[T]) Delete(index int32) error {
 	oldNode.Next.Prev = oldNode.Prev
 	oldNode.Prev.Next = oldNode.Next
 	l.length--
+	l.version++
 	return nil
 }
 
@@ -177,12 +201,14 @@ func (l *LinkedList[T]) DeleteFirst() error {
 		l.Tail = nil
 		l.Head = nil
 		l.length--
+		l.version++
 		return nil
 	}
 
 	l.Head = l.Head.Next
 	l.Head.Prev = nil
 	l.length--
+	l.version++
 	return nil
 }
 
@@ -195,12 +221,79 @@ func (l *LinkedList[T]) DeleteLast() error {
 		l.Tail = nil
 		l.Head = nil
 		l.length--
+		l.version++
 		return nil
 	}
 
 	l.Tail = l.Tail.Prev
 	l.Tail.Next = nil
 	l.length--
+	l.version++
+	return nil
+}
+
+// DeleteNode unlinks n in O(1). n must belong to l.
+func (l *LinkedList[T]) DeleteNode(n *node.Node[T]) error {
+	if n == nil || l.IsEmpty() {
+		return fmt.Errorf("cannot remove node")
+	}
+
+	if n.Prev == nil {
+		l.Head = n.Next
+	} else {
+		n.Prev.Next = n.Next
+	}
+	if n.Next == nil {
+		l.Tail = n.Prev
+	} else {
+		n.Next.Prev = n.Prev
+	}
+	n.Prev, n.Next = nil, nil
+	l.length--
+	l.version++
+	return nil
+}
+
+// MoveFirst relinks n, which must belong to l, at the head in O(1).
+func (l *LinkedList[T]) MoveFirst(n *node.Node[T]) error {
+	if n == nil {
+		return fmt.Errorf("cannot move nil node")
+	}
+	if n == l.Head {
+		return nil
+	}
+
+	err := l.DeleteNode(n)
+	if err != nil {
+		return err
+	}
+	n.Next = l.Head
+	l.Head.Prev = n
+	l.Head = n
+	l.length++
+	l.version++
+	return nil
+}
+
+// InsertAfter adds data after n, which must belong to l, in O(1). The new
+// node is n.Next.
+func (l *LinkedList[T]) InsertAfter(n *node.Node[T], data T) error {
+	if n == nil {
+		return fmt.Errorf("cannot insert after nil node")
+	}
+	if n == l.Tail {
+		return l.InsertLast(data)
+	}
+
+	newNode := node.NewNode[T]()
+	newNode.Data = data
+	newNode.Prev = n
+	newNode.Next = n.Next
+
+	n.Next.Prev = newNode
+	n.Next = newNode
+	l.length++
+	l.version++
 	return nil
 }
 
@@ -227,6 +320,7 @@ func (l *LinkedList[T]) Reverse() error {
 	head := l.Head
 	l.Head = l.Tail
 	l.Tail = head
+	l.version++
 	return nil
 }
 
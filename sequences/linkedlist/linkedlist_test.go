@@ -197,6 +197,19 @@ func TestLinkedList_Insert(t *testing.T) {
 				return l.Head.Next.Data == "Node 2"
 			},
 		},
+		{
+			name: "insert at the head of a non-empty list",
+			list: NewList("Node 1", "Node 2"),
+			args: args{
+				index: 0,
+				data:  "Node 0",
+			},
+			want: func(l *LinkedList[string]) bool {
+				is.EqualValues(3, l.GetSize())
+				is.Equal("Node 1", l.Head.Next.Data)
+				return l.Head.Data == "Node 0"
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -457,3 +470,147 @@ func TestLinkedList_ToArray(t *testing.T) {
 		})
 	}
 }
+
+// backwards walks the list from the tail so that broken Prev links show up.
+func backwards[T comparable](ll *LinkedList[T]) []T {
+	data := []T{}
+	for it := ll.Tail; it != nil; it = it.Prev {
+		data = append([]T{it.Data}, data...)
+	}
+	return data
+}
+
+func TestLinkedList_DeleteNode(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name  string
+		list  *LinkedList[string]
+		index int32
+		want  []string
+	}{
+		{
+			name:  "remove head",
+			list:  NewList("A", "B", "C"),
+			index: 0,
+			want:  []string{"B", "C"},
+		},
+		{
+			name:  "remove middle",
+			list:  NewList("A", "B", "C"),
+			index: 1,
+			want:  []string{"A", "C"},
+		},
+		{
+			name:  "remove tail",
+			list:  NewList("A", "B", "C"),
+			index: 2,
+			want:  []string{"A", "B"},
+		},
+		{
+			name:  "remove only node",
+			list:  NewList("A"),
+			index: 0,
+			want:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := tt.list.GetNode(tt.index)
+			is.Nil(err)
+			is.Nil(tt.list.DeleteNode(n))
+
+			got, err := tt.list.ToArray()
+			is.Nil(err)
+			is.Equal(tt.want, got)
+			is.Equal(tt.want, backwards(tt.list))
+			is.EqualValues(len(tt.want), tt.list.GetSize())
+		})
+	}
+
+	is.Error(NewList[string]().DeleteNode(nil))
+}
+
+func TestLinkedList_MoveFirst(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name  string
+		list  *LinkedList[string]
+		index int32
+		want  []string
+	}{
+		{
+			name:  "move head",
+			list:  NewList("A", "B", "C"),
+			index: 0,
+			want:  []string{"A", "B", "C"},
+		},
+		{
+			name:  "move middle",
+			list:  NewList("A", "B", "C"),
+			index: 1,
+			want:  []string{"B", "A", "C"},
+		},
+		{
+			name:  "move tail",
+			list:  NewList("A", "B", "C"),
+			index: 2,
+			want:  []string{"C", "A", "B"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := tt.list.GetNode(tt.index)
+			is.Nil(err)
+			is.Nil(tt.list.MoveFirst(n))
+
+			got, err := tt.list.ToArray()
+			is.Nil(err)
+			is.Equal(tt.want, got)
+			is.Equal(tt.want, backwards(tt.list))
+			is.EqualValues(3, tt.list.GetSize())
+		})
+	}
+}
+
+func TestLinkedList_InsertAfter(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name  string
+		list  *LinkedList[string]
+		index int32
+		want  []string
+	}{
+		{
+			name:  "insert after head",
+			list:  NewList("A", "B", "C"),
+			index: 0,
+			want:  []string{"A", "X", "B", "C"},
+		},
+		{
+			name:  "insert after tail",
+			list:  NewList("A", "B", "C"),
+			index: 2,
+			want:  []string{"A", "B", "C", "X"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := tt.list.GetNode(tt.index)
+			is.Nil(err)
+			is.Nil(tt.list.InsertAfter(n, "X"))
+			is.Equal("X", n.Next.Data)
+
+			got, err := tt.list.ToArray()
+			is.Nil(err)
+			is.Equal(tt.want, got)
+			is.Equal(tt.want, backwards(tt.list))
+			is.EqualValues(4, tt.list.GetSize())
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package linkedlist
+
+import (
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+	"github.com/OladapoAjala/datastructures/sequences/sequencetest"
+)
+
+func Test_Sequencer(t *testing.T) {
+	sequencetest.Run(t, func() sequences.Sequencer[int] {
+		return NewList[int]()
+	})
+}
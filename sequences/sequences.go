@@ -1,6 +1,9 @@
 package sequences
 
+import "iter"
+
 type Sequencer[T any] interface {
+	Iterable[T]
 	GetData(int32) (T, error)
 	Contains(T) bool
 	Insert(int32, T) error // put new value at index
@@ -13,3 +16,11 @@ type Sequencer[T any] interface {
 	GetSize() int32
 	IsEmpty() bool
 }
+
+// Assigner is implemented by sequences that can overwrite their elements in
+// order in a single O(n) pass, for those whose Set is slower than O(1).
+// Assign stops when either the sequence or values runs out. Like Set, it is
+// not a structural change.
+type Assigner[T any] interface {
+	Assign(values iter.Seq[T])
+}
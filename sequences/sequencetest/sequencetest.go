@@ -0,0 +1,246 @@
+// Package sequencetest is a conformance suite for sequences.Sequencer. The
+// deque, gap buffer, linked list, tiered vector and binary tree run it from
+// their own tests:
+//
+//	func Test_Sequencer(t *testing.T) {
+//		sequencetest.Run(t, func() sequences.Sequencer[int] {
+//			return NewThing[int]()
+//		})
+//	}
+//
+// The arrays only run Iterate.
+package sequencetest
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+	"github.com/stretchr/testify/assert"
+)
+
+// Run checks the sequences made by newSequence, which must start empty,
+// against a slice. Only positive values are stored, since some sequences
+// reserve the zero value.
+func Run(t *testing.T, newSequence func() sequences.Sequencer[int]) {
+	tests := []struct {
+		name string
+		run  func(*assert.Assertions, sequences.Sequencer[int])
+	}{
+		{name: "ends", run: ends},
+		{name: "middle", run: middle},
+		{name: "errors", run: errors},
+		{name: "random", run: random},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(assert.New(t), newSequence())
+		})
+	}
+
+	t.Run("iteration", func(t *testing.T) {
+		Iterate(t, func(values ...int) sequences.Sequencer[int] {
+			s := newSequence()
+			for _, v := range values {
+				if err := s.InsertLast(v); err != nil {
+					t.Fatal(err)
+				}
+			}
+			return s
+		})
+	})
+}
+
+// Iterate checks the iterators of the sequences made by newSequence, which
+// must hold the given values in order. Run calls it; sequences that cannot
+// run the whole suite call it directly.
+func Iterate(t *testing.T, newSequence func(values ...int) sequences.Sequencer[int]) {
+	tests := []struct {
+		name string
+		run  func(*assert.Assertions, sequences.Sequencer[int])
+	}{
+		{name: "forward and backward", run: forwardAndBackward},
+		{name: "break early", run: breakEarly},
+		{name: "set is seen", run: setIsSeen},
+		{name: "exhausted iterator", run: exhausted},
+		{name: "fail fast", run: failFast},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(assert.New(t), newSequence(1, 2, 3, 4))
+		})
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		is := assert.New(t)
+		s := newSequence()
+		for range s.All() {
+			is.Fail("empty sequence yielded")
+		}
+		it := s.ReverseIterator()
+		is.False(it.Next())
+		is.Nil(it.Err())
+	})
+}
+
+// check compares s with want through every read method.
+func check(is *assert.Assertions, want []int, s sequences.Sequencer[int]) {
+	is.EqualValues(len(want), s.GetSize())
+	is.Equal(len(want) == 0, s.IsEmpty())
+	for i, w := range want {
+		v, err := s.GetData(int32(i))
+		is.Nil(err)
+		is.Equal(w, v, "index %d", i)
+	}
+	got := slices.Collect(s.Values())
+	if len(want) == 0 {
+		is.Empty(got)
+	} else {
+		is.Equal(want, got)
+	}
+}
+
+func ends(is *assert.Assertions, s sequences.Sequencer[int]) {
+	check(is, nil, s)
+	is.Nil(s.InsertLast(2))
+	is.Nil(s.InsertFirst(1))
+	is.Nil(s.InsertLast(3))
+	check(is, []int{1, 2, 3}, s)
+
+	is.Nil(s.DeleteFirst())
+	check(is, []int{2, 3}, s)
+	is.Nil(s.DeleteLast())
+	check(is, []int{2}, s)
+	is.Nil(s.DeleteLast())
+	check(is, nil, s)
+}
+
+func middle(is *assert.Assertions, s sequences.Sequencer[int]) {
+	for i := 1; i <= 5; i++ {
+		is.Nil(s.InsertLast(i))
+	}
+	is.Nil(s.Insert(0, 10))
+	is.Nil(s.Insert(3, 11))
+	is.Nil(s.Insert(s.GetSize(), 12))
+	check(is, []int{10, 1, 2, 11, 3, 4, 5, 12}, s)
+
+	is.Nil(s.Set(2, 20))
+	is.Nil(s.Delete(3))
+	is.Nil(s.Delete(0))
+	check(is, []int{1, 20, 3, 4, 5, 12}, s)
+	is.True(s.Contains(20))
+	is.False(s.Contains(2))
+}
+
+func errors(is *assert.Assertions, s sequences.Sequencer[int]) {
+	is.Error(s.DeleteFirst())
+	is.Error(s.DeleteLast())
+	_, err := s.GetData(0)
+	is.Error(err)
+
+	is.Nil(s.InsertLast(1))
+	_, err = s.GetData(1)
+	is.Error(err)
+	is.Error(s.Delete(1))
+	is.Error(s.Set(1, 2))
+	check(is, []int{1}, s)
+}
+
+func forwardAndBackward(is *assert.Assertions, s sequences.Sequencer[int]) {
+	var indices []int32
+	var values []int
+	for i, v := range s.All() {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+	is.Equal([]int32{0, 1, 2, 3}, indices)
+	is.Equal([]int{1, 2, 3, 4}, values)
+
+	indices, values = nil, nil
+	for i, v := range s.Backward() {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+	is.Equal([]int32{3, 2, 1, 0}, indices)
+	is.Equal([]int{4, 3, 2, 1}, values)
+
+	is.Equal([]int{1, 2, 3, 4}, slices.Collect(s.Values()))
+}
+
+func breakEarly(is *assert.Assertions, s sequences.Sequencer[int]) {
+	var values []int
+	for v := range s.Values() {
+		if v == 3 {
+			break
+		}
+		values = append(values, v)
+	}
+	is.Equal([]int{1, 2}, values)
+}
+
+func setIsSeen(is *assert.Assertions, s sequences.Sequencer[int]) {
+	var values []int
+	for i, v := range s.All() {
+		if i == 0 {
+			is.Nil(s.Set(1, 9))
+		}
+		values = append(values, v)
+	}
+	is.Equal([]int{1, 9, 3, 4}, values)
+}
+
+func exhausted(is *assert.Assertions, s sequences.Sequencer[int]) {
+	it := s.ReverseIterator()
+	for it.Next() {
+	}
+	is.False(it.Next())
+	is.EqualValues(-1, it.Index())
+	is.Zero(it.Value())
+	is.Nil(it.Err())
+}
+
+func failFast(is *assert.Assertions, s sequences.Sequencer[int]) {
+	it := s.Iterator()
+	is.True(it.Next())
+	is.Nil(s.DeleteFirst())
+	is.False(it.Next())
+	is.ErrorIs(it.Err(), sequences.ErrConcurrentModification)
+
+	is.PanicsWithValue(sequences.ErrConcurrentModification, func() {
+		for range s.Backward() {
+			_ = s.InsertLast(5)
+		}
+	})
+}
+
+func random(is *assert.Assertions, s sequences.Sequencer[int]) {
+	r := rand.New(rand.NewSource(49))
+	var model []int
+
+	for i := 1; i <= 1500; i++ {
+		switch op := r.Intn(6); {
+		case op == 0 && len(model) > 0:
+			at := r.Intn(len(model))
+			is.Nil(s.Delete(int32(at)))
+			model = slices.Delete(model, at, at+1)
+		case op == 1 && len(model) > 0:
+			at := r.Intn(len(model))
+			is.Nil(s.Set(int32(at), i))
+			model[at] = i
+		case op == 2 && len(model) > 0:
+			is.Nil(s.DeleteLast())
+			model = model[:len(model)-1]
+		default:
+			at := r.Intn(len(model) + 1)
+			is.Nil(s.Insert(int32(at), i))
+			model = slices.Insert(model, at, i)
+		}
+		if i%50 == 0 {
+			check(is, model, s)
+		}
+	}
+	check(is, model, s)
+}
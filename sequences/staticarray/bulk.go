@@ -0,0 +1,51 @@
+package staticarray
+
+import "fmt"
+
+// FromSlice returns an array as long as data holding a copy of it.
+func FromSlice[T comparable](data []T) *StaticArray[T] {
+	return NewStaticArray(int32(len(data)), data...)
+}
+
+// ToSlice returns a copy of the elements.
+func (sa *StaticArray[T]) ToSlice() []T {
+	return append([]T(nil), sa.array[:sa.length]...)
+}
+
+func (sa *StaticArray[T]) Clone() *StaticArray[T] {
+	return FromSlice(sa.array[:sa.length])
+}
+
+// InsertAll puts data at index and shifts the elements after it along in a
+// single pass. As with Insert the length is fixed, so elements shifted past
+// the end are lost, as is any of data that does not fit.
+func (sa *StaticArray[T]) InsertAll(index int32, data ...T) error {
+	if index < 0 || index >= sa.length {
+		return fmt.Errorf("index out of range")
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	k := min(int32(len(data)), sa.length-index)
+	copy(sa.array[index+k:], sa.array[index:sa.length-k])
+	copy(sa.array[index:], data[:k])
+	sa.version++
+	return nil
+}
+
+// DeleteRange removes the elements from index i up to, but not including,
+// index j, shifting the rest down and filling the end with zero values.
+func (sa *StaticArray[T]) DeleteRange(i, j int32) error {
+	if i < 0 || j > sa.length || i > j {
+		return fmt.Errorf("invalid range [%d, %d)", i, j)
+	}
+	if i == j {
+		return nil
+	}
+
+	copy(sa.array[i:], sa.array[j:sa.length])
+	clear(sa.array[sa.length-(j-i) : sa.length])
+	sa.version++
+	return nil
+}
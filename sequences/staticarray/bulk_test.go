@@ -0,0 +1,65 @@
+package staticarray
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Bulk(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name string
+		want func()
+	}{
+		{
+			name: "slice round trip",
+			want: func() {
+				data := []int{1, 2, 3}
+				sa := FromSlice(data)
+				data[0] = 9
+				is.Equal([]int{1, 2, 3}, sa.ToSlice())
+				is.EqualValues(3, sa.GetSize())
+			},
+		},
+		{
+			name: "clone is independent",
+			want: func() {
+				sa := FromSlice([]int{1, 2, 3})
+				clone := sa.Clone()
+				is.Nil(clone.Set(0, 9))
+				is.Equal([]int{1, 2, 3}, sa.ToSlice())
+				is.Equal([]int{9, 2, 3}, clone.ToSlice())
+			},
+		},
+		{
+			name: "insert all drops what falls off the end",
+			want: func() {
+				sa := FromSlice([]int{1, 2, 3, 4, 5})
+				is.Nil(sa.InsertAll(1, 7, 8))
+				is.Equal([]int{1, 7, 8, 2, 3}, sa.ToSlice())
+				is.Nil(sa.InsertAll(3, 4, 5, 6, 7))
+				is.Equal([]int{1, 7, 8, 4, 5}, sa.ToSlice())
+				is.Error(sa.InsertAll(5, 1))
+			},
+		},
+		{
+			name: "delete range",
+			want: func() {
+				sa := FromSlice([]int{1, 2, 3, 4, 5})
+				is.Nil(sa.DeleteRange(1, 3))
+				is.Equal([]int{1, 4, 5, 0, 0}, sa.ToSlice())
+				is.Nil(sa.DeleteRange(0, 5))
+				is.Equal([]int{0, 0, 0, 0, 0}, sa.ToSlice())
+				is.Error(sa.DeleteRange(2, 6))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want()
+		})
+	}
+}
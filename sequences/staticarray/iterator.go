@@ -0,0 +1,37 @@
+package staticarray
+
+import (
+	"iter"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+)
+
+// Iterator visits the array's elements by index. It is fail-fast: once
+// an insert or delete shifts the elements, Next returns false and Err reports
+// sequences.ErrConcurrentModification. Elements overwritten with Set are seen
+// by the iterator.
+func (sa *StaticArray[T]) Iterator() sequences.Iterator[T] {
+	return sequences.NewIndexIterator(sa.at, sa.GetSize, &sa.version, false)
+}
+
+// ReverseIterator is Iterator from the last element to the first.
+func (sa *StaticArray[T]) ReverseIterator() sequences.Iterator[T] {
+	return sequences.NewIndexIterator(sa.at, sa.GetSize, &sa.version, true)
+}
+
+// at returns element i, which must be in range.
+func (sa *StaticArray[T]) at(i int32) T {
+	return sa.array[i]
+}
+
+func (sa *StaticArray[T]) All() iter.Seq2[int32, T] {
+	return sequences.All(sa.Iterator)
+}
+
+func (sa *StaticArray[T]) Backward() iter.Seq2[int32, T] {
+	return sequences.All(sa.ReverseIterator)
+}
+
+func (sa *StaticArray[T]) Values() iter.Seq[T] {
+	return sequences.Values(sa.Iterator)
+}
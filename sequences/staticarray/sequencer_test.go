@@ -0,0 +1,15 @@
+package staticarray
+
+import (
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+	"github.com/OladapoAjala/datastructures/sequences/sequencetest"
+)
+
+// StaticArray has a fixed length, so it only runs the iteration checks.
+func Test_Iterator(t *testing.T) {
+	sequencetest.Iterate(t, func(values ...int) sequences.Sequencer[int] {
+		return NewStaticArray(int32(len(values)), values...)
+	})
+}
@@ -10,11 +10,18 @@ import (
 type StaticArray[T comparable] struct {
 	array  []T
 	length int32
+	// version counts inserts and deletes, which shift the elements, for
+	// fail-fast iteration.
+	version uint64
 }
 
 type IStaticArray[T comparable] interface {
 	sequences.Sequencer[T]
 	ToLinkedList() (*linkedlist.LinkedList[T], error)
+	ToSlice() []T
+	Clone() *StaticArray[T]
+	InsertAll(int32, ...T) error
+	DeleteRange(int32, int32) error
 }
 
 var _ IStaticArray[string] = new(StaticArray[string])
@@ -66,6 +73,7 @@ func (sa *StaticArray[T]) Insert(index int32, data T) error {
 		newArray[i+1] = sa.array[i]
 	}
 	sa.array = newArray
+	sa.version++
 	return nil
 }
 
@@ -83,6 +91,7 @@ func (sa *StaticArray[T]) Delete(index int32) error {
 	}
 
 	sa.shift(index)
+	sa.version++
 	return nil
 }
 
@@ -0,0 +1,38 @@
+package tieredvector
+
+import (
+	"iter"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+)
+
+// Iterator visits the vector's elements by index. It is fail-fast: once an
+// insert or delete changes the elements, Next returns false and Err reports
+// sequences.ErrConcurrentModification. Elements overwritten with Set are seen
+// by the iterator.
+func (tv *TieredVector[T]) Iterator() sequences.Iterator[T] {
+	return sequences.NewIndexIterator(tv.at, tv.GetSize, &tv.version, false)
+}
+
+// ReverseIterator is Iterator from the last element to the first.
+func (tv *TieredVector[T]) ReverseIterator() sequences.Iterator[T] {
+	return sequences.NewIndexIterator(tv.at, tv.GetSize, &tv.version, true)
+}
+
+// at returns element i, which must be in range.
+func (tv *TieredVector[T]) at(i int32) T {
+	v, _ := tv.blocks[i/tv.width].GetData(i % tv.width)
+	return v
+}
+
+func (tv *TieredVector[T]) All() iter.Seq2[int32, T] {
+	return sequences.All(tv.Iterator)
+}
+
+func (tv *TieredVector[T]) Backward() iter.Seq2[int32, T] {
+	return sequences.All(tv.ReverseIterator)
+}
+
+func (tv *TieredVector[T]) Values() iter.Seq[T] {
+	return sequences.Values(tv.Iterator)
+}
@@ -0,0 +1,197 @@
+package tieredvector
+
+import (
+	"fmt"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+	"github.com/OladapoAjala/datastructures/sequences/deque"
+)
+
+// TieredVector splits its elements into blocks of width elements, each a
+// ring buffer, so that every block but the last is full. Element i is at
+// offset i%width of block i/width, which keeps indexing O(1).
+//
+// An insert shifts the elements of one block, then passes the block's last
+// element to the front of the next block, and so on to the end; each ring
+// buffer takes its new first element in O(1). A delete works the same way
+// in reverse. Keeping width near the square root of the length, by
+// rebuilding with double or half the width whenever the length leaves
+// [width²/8, width²], makes both O(√n) amortised. Each rebuild leaves the
+// length well inside the new bounds, so a sequence hovering at a boundary
+// does not rebuild on every call.
+type TieredVector[T comparable] struct {
+	blocks []*deque.Deque[T]
+	width  int32
+	length int32
+	// version counts structural changes, for fail-fast iteration.
+	version uint64
+}
+
+var _ sequences.Sequencer[string] = new(TieredVector[string])
+
+// MIN_WIDTH is the smallest block width, below which rebuilding would cost
+// more than it saves.
+const MIN_WIDTH = 4
+
+func NewTieredVector[T comparable](data ...T) *TieredVector[T] {
+	width := int32(MIN_WIDTH)
+	for width*width < int32(len(data)) {
+		width *= 2
+	}
+	tv := new(TieredVector[T])
+	tv.rebuild(data, width)
+	return tv
+}
+
+// rebuild lays data out in blocks of the given width.
+func (tv *TieredVector[T]) rebuild(data []T, width int32) {
+	tv.width = width
+	tv.length = int32(len(data))
+	tv.blocks = nil
+	for i := 0; i < len(data); i += int(width) {
+		block := tv.newBlock()
+		for _, v := range data[i:min(i+int(width), len(data))] {
+			block.InsertLast(v)
+		}
+	}
+}
+
+// newBlock appends an empty block.
+func (tv *TieredVector[T]) newBlock() *deque.Deque[T] {
+	block, _ := deque.NewRingBuffer[T](tv.width)
+	tv.blocks = append(tv.blocks, block)
+	return block
+}
+
+func (tv *TieredVector[T]) elements() []T {
+	data := make([]T, 0, tv.length)
+	for _, block := range tv.blocks {
+		for v := range block.Values() {
+			data = append(data, v)
+		}
+	}
+	return data
+}
+
+func (tv *TieredVector[T]) GetData(index int32) (T, error) {
+	if index < 0 || index >= tv.length {
+		return *new(T), fmt.Errorf("index out of range")
+	}
+	return tv.blocks[index/tv.width].GetData(index % tv.width)
+}
+
+func (tv *TieredVector[T]) Contains(data T) bool {
+	for _, block := range tv.blocks {
+		if block.Contains(data) {
+			return true
+		}
+	}
+	return false
+}
+
+func (tv *TieredVector[T]) Set(index int32, data T) error {
+	if index < 0 || index >= tv.length {
+		return fmt.Errorf("index out of range")
+	}
+	return tv.blocks[index/tv.width].Set(index%tv.width, data)
+}
+
+// Insert puts data at index, which may be GetSize to append.
+func (tv *TieredVector[T]) Insert(index int32, data T) error {
+	if index < 0 || index > tv.length {
+		return fmt.Errorf("index out of range")
+	}
+	if tv.length == tv.width*tv.width {
+		tv.rebuild(tv.elements(), max(2*tv.width, MIN_WIDTH))
+	}
+
+	k, offset := int(index/tv.width), index%tv.width
+	for carry := data; ; k, offset = k+1, 0 {
+		if k == len(tv.blocks) {
+			tv.newBlock()
+		}
+		block := tv.blocks[k]
+		if !block.IsFull() {
+			if err := block.Insert(offset, carry); err != nil {
+				return err
+			}
+			break
+		}
+
+		last, err := block.PopLast()
+		if err != nil {
+			return err
+		}
+		if err := block.Insert(offset, carry); err != nil {
+			return err
+		}
+		carry = last
+	}
+
+	tv.length++
+	tv.version++
+	return nil
+}
+
+func (tv *TieredVector[T]) InsertFirst(data T) error {
+	return tv.Insert(0, data)
+}
+
+func (tv *TieredVector[T]) InsertLast(data T) error {
+	return tv.Insert(tv.length, data)
+}
+
+func (tv *TieredVector[T]) Delete(index int32) error {
+	if tv.IsEmpty() {
+		return fmt.Errorf("cannot remove from empty vector")
+	}
+	if index < 0 || index >= tv.length {
+		return fmt.Errorf("index out of range")
+	}
+
+	k := int(index / tv.width)
+	if err := tv.blocks[k].Delete(index % tv.width); err != nil {
+		return err
+	}
+	for ; k+1 < len(tv.blocks); k++ {
+		first, err := tv.blocks[k+1].PopFirst()
+		if err != nil {
+			return err
+		}
+		if err := tv.blocks[k].InsertLast(first); err != nil {
+			return err
+		}
+	}
+	if last := len(tv.blocks) - 1; tv.blocks[last].IsEmpty() {
+		tv.blocks[last] = nil
+		tv.blocks = tv.blocks[:last]
+	}
+
+	tv.length--
+	tv.version++
+	if tv.width > MIN_WIDTH && tv.length < tv.width*tv.width/8 {
+		tv.rebuild(tv.elements(), tv.width/2)
+	}
+	return nil
+}
+
+func (tv *TieredVector[T]) DeleteFirst() error {
+	return tv.Delete(0)
+}
+
+func (tv *TieredVector[T]) DeleteLast() error {
+	return tv.Delete(tv.length - 1)
+}
+
+func (tv *TieredVector[T]) GetSize() int32 {
+	return tv.length
+}
+
+// GetWidth returns the number of elements per block.
+func (tv *TieredVector[T]) GetWidth() int32 {
+	return tv.width
+}
+
+func (tv *TieredVector[T]) IsEmpty() bool {
+	return tv.length == 0
+}
@@ -0,0 +1,87 @@
+package tieredvector
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Width(t *testing.T) {
+	is := assert.New(t)
+
+	tests := []struct {
+		name string
+		want func()
+	}{
+		{
+			name: "constructor sizes the blocks",
+			want: func() {
+				is.EqualValues(MIN_WIDTH, NewTieredVector[int]().GetWidth())
+				is.EqualValues(MIN_WIDTH, NewTieredVector(make([]int, 16)...).GetWidth())
+				tv := NewTieredVector(make([]int, 17)...)
+				is.EqualValues(8, tv.GetWidth())
+				is.Len(tv.blocks, 3)
+			},
+		},
+		{
+			name: "width follows the square root of the length",
+			want: func() {
+				tv := NewTieredVector[int]()
+				var model []int
+				for i := 1; i <= 1000; i++ {
+					at := (i * 7) % (len(model) + 1)
+					is.Nil(tv.Insert(int32(at), i))
+					model = slices.Insert(model, at, i)
+				}
+				is.EqualValues(32, tv.GetWidth())
+				is.Equal(model, slices.Collect(tv.Values()))
+
+				for len(model) > 20 {
+					at := (len(model) * 5) % len(model) / 2
+					is.Nil(tv.Delete(int32(at)))
+					model = slices.Delete(model, at, at+1)
+				}
+				is.EqualValues(8, tv.GetWidth())
+				is.Equal(model, slices.Collect(tv.Values()))
+			},
+		},
+		{
+			name: "alternating at the boundary does not rebuild",
+			want: func() {
+				tv := NewTieredVector(make([]int, 16)...)
+				is.EqualValues(4, tv.GetWidth())
+				is.Nil(tv.InsertLast(1))
+				is.EqualValues(8, tv.GetWidth())
+				blocks := tv.blocks[0]
+				for i := 0; i < 100; i++ {
+					is.Nil(tv.DeleteLast())
+					is.Nil(tv.InsertLast(1))
+				}
+				is.EqualValues(8, tv.GetWidth())
+				is.Same(blocks, tv.blocks[0])
+				is.EqualValues(17, tv.GetSize())
+			},
+		},
+		{
+			name: "all blocks but the last are full",
+			want: func() {
+				tv := NewTieredVector(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+				is.Nil(tv.Insert(2, 0))
+				is.Nil(tv.Delete(7))
+				is.Nil(tv.DeleteFirst())
+				for _, block := range tv.blocks[:len(tv.blocks)-1] {
+					is.True(block.IsFull())
+				}
+				is.False(tv.blocks[len(tv.blocks)-1].IsEmpty())
+				is.Equal([]int{2, 0, 3, 4, 5, 6, 8, 9, 10}, slices.Collect(tv.Values()))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want()
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package sets
+
+// The operations below write their result into dst, which must not be a or
+// b, and stop at the first error from dst.Insert. Operands may be any
+// implementations of Set.
+
+func Union[K comparable](dst Inserter[K], a, b Set[K]) error {
+	if err := insertIf(dst, a, func(K) bool { return true }); err != nil {
+		return err
+	}
+	return insertIf(dst, b, func(key K) bool { return !a.Contains(key) })
+}
+
+// Intersection walks the smaller operand and looks its keys up in the other.
+func Intersection[K comparable](dst Inserter[K], a, b Set[K]) error {
+	if b.Size() < a.Size() {
+		a, b = b, a
+	}
+	return insertIf(dst, a, b.Contains)
+}
+
+// Difference inserts the keys of a that are not in b.
+func Difference[K comparable](dst Inserter[K], a, b Set[K]) error {
+	return insertIf(dst, a, func(key K) bool { return !b.Contains(key) })
+}
+
+// SymmetricDifference inserts the keys in exactly one of a and b.
+func SymmetricDifference[K comparable](dst Inserter[K], a, b Set[K]) error {
+	if err := Difference(dst, a, b); err != nil {
+		return err
+	}
+	return Difference(dst, b, a)
+}
+
+// IsSubset reports whether every key of a is in b.
+func IsSubset[K comparable](a, b Set[K]) bool {
+	if a.Size() > b.Size() {
+		return false
+	}
+	return all(a, b.Contains)
+}
+
+func IsSuperset[K comparable](a, b Set[K]) bool {
+	return IsSubset(b, a)
+}
+
+func Equal[K comparable](a, b Set[K]) bool {
+	return a.Size() == b.Size() && all(a, b.Contains)
+}
+
+func IsDisjoint[K comparable](a, b Set[K]) bool {
+	if b.Size() < a.Size() {
+		a, b = b, a
+	}
+	return all(a, func(key K) bool { return !b.Contains(key) })
+}
+
+func insertIf[K comparable](dst Inserter[K], from Set[K], keep func(K) bool) error {
+	var err error
+	from.Range(func(key K) bool {
+		if keep(key) {
+			err = dst.Insert(key)
+		}
+		return err == nil
+	})
+	return err
+}
+
+func all[K comparable](s Set[K], f func(K) bool) bool {
+	ok := true
+	s.Range(func(key K) bool {
+		ok = f(key)
+		return ok
+	})
+	return ok
+}
@@ -0,0 +1,103 @@
+package sets_test
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/sets"
+	"github.com/OladapoAjala/datastructures/sets/data"
+	"github.com/OladapoAjala/datastructures/sets/hashset"
+	"github.com/OladapoAjala/datastructures/sets/sortedarray"
+	"github.com/stretchr/testify/assert"
+)
+
+func newHashSet(keys ...int) sets.Set[int] {
+	s := hashset.NewHashSet[int](0)
+	_ = s.InsertAll(keys...)
+	return s
+}
+
+func newSortedArray(keys ...int) sets.Set[int] {
+	values := make([]*data.Data[int, struct{}], len(keys))
+	for i, key := range keys {
+		values[i] = data.NewData(key, struct{}{})
+	}
+	return sortedarray.NewSortedArray(values...)
+}
+
+var impls = []struct {
+	name string
+	new  func(...int) sets.Set[int]
+}{
+	{"hashset", newHashSet},
+	{"sortedarray", newSortedArray},
+}
+
+func keys(s *hashset.HashSet[int]) []int {
+	out := s.Keys()
+	sort.Ints(out)
+	return out
+}
+
+func Test_Algebra(t *testing.T) {
+	is := assert.New(t)
+
+	ops := []struct {
+		name string
+		op   func(sets.Inserter[int], sets.Set[int], sets.Set[int]) error
+		want []int
+	}{
+		{"union", sets.Union[int], []int{1, 2, 3, 4, 5, 6}},
+		{"intersection", sets.Intersection[int], []int{3, 4}},
+		{"difference", sets.Difference[int], []int{1, 2}},
+		{"symmetric difference", sets.SymmetricDifference[int], []int{1, 2, 5, 6}},
+	}
+
+	// Every pairing of implementations, in both roles.
+	for _, x := range impls {
+		for _, y := range impls {
+			for _, tt := range ops {
+				t.Run(x.name+"/"+y.name+"/"+tt.name, func(t *testing.T) {
+					a, b := x.new(1, 2, 3, 4), y.new(3, 4, 5, 6)
+					dst := hashset.NewHashSet[int](0)
+					is.Nil(tt.op(dst, a, b))
+					is.Equal(tt.want, keys(dst))
+				})
+			}
+
+			t.Run(x.name+"/"+y.name+"/predicates", func(t *testing.T) {
+				is.True(sets.IsSubset(x.new(1, 2), y.new(1, 2, 3)))
+				is.False(sets.IsSubset(x.new(1, 4), y.new(1, 2, 3)))
+				is.False(sets.IsSubset(x.new(1, 2, 3, 4), y.new(1, 2, 3)))
+				is.True(sets.IsSubset(x.new(), y.new(1)))
+				is.True(sets.IsSuperset(x.new(1, 2, 3), y.new(3)))
+				is.True(sets.Equal(x.new(3, 1, 2), y.new(1, 2, 3)))
+				is.False(sets.Equal(x.new(1, 2), y.new(1, 3)))
+				is.True(sets.IsDisjoint(x.new(1, 2), y.new(3, 4, 5)))
+				is.False(sets.IsDisjoint(x.new(1, 2), y.new(2, 4, 5)))
+			})
+		}
+	}
+}
+
+type failingInserter struct{ calls int }
+
+var errFull = errors.New("full")
+
+func (f *failingInserter) Insert(int) error {
+	f.calls++
+	if f.calls == 2 {
+		return errFull
+	}
+	return nil
+}
+
+func Test_AlgebraStopsOnError(t *testing.T) {
+	is := assert.New(t)
+	dst := new(failingInserter)
+
+	err := sets.Union[int](dst, newHashSet(1, 2, 3), newSortedArray(4, 5))
+	is.ErrorIs(err, errFull)
+	is.Equal(2, dst.calls)
+}
@@ -3,31 +3,33 @@ package data
 import (
 	"hash/fnv"
 	"strconv"
-
-	"golang.org/x/exp/constraints"
 )
 
-type Data[K constraints.Ordered, V any] struct {
+type Data[K comparable, V any] struct {
 	Key   K
 	Value V
 	hash  uint32
+	tomb  bool
 }
 
-type IData[K constraints.Ordered, V any] interface {
+type IData[K comparable, V any] interface {
 	GetKey() K
 	GetValue() V
 }
 
 var _ IData[int, string] = new(Data[int, string])
 
-func NewData[K constraints.Ordered, V any](key K, val V) *Data[K, V] {
+func NewData[K comparable, V any](key K, val V) *Data[K, V] {
 	return &Data[K, V]{
 		Key:   key,
 		Value: val,
 	}
 }
 
-func NewDataWithHash[K constraints.Ordered, V any](key K, val V) *Data[K, V] {
+// NewDataWithHash hashes ToString(key) with unseeded FNV-32. Tables that
+// take untrusted keys should hash with a seeded hashtables.Hasher and use
+// NewHashedData instead.
+func NewDataWithHash[K comparable, V any](key K, val V) *Data[K, V] {
 	hasher := fnv.New32()
 	hasher.Write([]byte(ToString(key)))
 
@@ -38,9 +40,20 @@ func NewDataWithHash[K constraints.Ordered, V any](key K, val V) *Data[K, V] {
 	}
 }
 
-func NewTombStone[K constraints.Ordered, V any]() *Data[K, any] {
-	return &Data[K, any]{
+// NewHashedData stores a hash computed by the caller, for tables that do not
+// use the default FNV hash of ToString(key).
+func NewHashedData[K comparable, V any](key K, val V, hash uint32) *Data[K, V] {
+	return &Data[K, V]{
+		Key:   key,
+		Value: val,
+		hash:  hash,
+	}
+}
+
+func NewTombStone[K comparable, V any]() *Data[K, V] {
+	return &Data[K, V]{
 		hash: 0xDEAD,
+		tomb: true,
 	}
 }
 
@@ -59,7 +72,7 @@ func (d *Data[K, V]) Equal(val *Data[K, V]) bool {
 }
 
 func (d *Data[K, V]) IsTombStone() bool {
-	return d.hash == 0xDEAD
+	return d.tomb
 }
 
 func (d *Data[K, V]) GetKey() K {
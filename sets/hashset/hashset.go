@@ -0,0 +1,111 @@
+package hashset
+
+import (
+	"github.com/OladapoAjala/datastructures/hashtables"
+	seperatechaining "github.com/OladapoAjala/datastructures/hashtables/seperate_chaining"
+	"github.com/OladapoAjala/datastructures/sets"
+)
+
+const DEFAULT_CAPACITY = 16
+
+// HashSet is an unordered set with expected O(1) Insert, Delete and
+// Contains. Like the hash tables it is built on, it rejects the zero key.
+type HashSet[K comparable] struct {
+	table  *seperatechaining.HashTable[K, struct{}]
+	hasher hashtables.Hasher[K]
+}
+
+type IHashSet[K comparable] interface {
+	sets.Set[K]
+	sets.Inserter[K]
+	Delete(K) error
+	Keys() []K
+}
+
+var _ IHashSet[string] = new(HashSet[string])
+
+func NewHashSet[K comparable](capacity int32) *HashSet[K] {
+	return NewHashSetWithHasher[K](capacity, hashtables.FNVHasher[K]{})
+}
+
+func NewHashSetWithHasher[K comparable](capacity int32, hasher hashtables.Hasher[K]) *HashSet[K] {
+	if capacity == 0 {
+		capacity = DEFAULT_CAPACITY
+	}
+	return &HashSet[K]{
+		table:  seperatechaining.NewHashTableWithHasher[K, struct{}](capacity, hasher),
+		hasher: hasher,
+	}
+}
+
+// Insert adds key, doing nothing if it is already present.
+func (s *HashSet[K]) Insert(key K) error {
+	return s.table.Insert(key, struct{}{})
+}
+
+func (s *HashSet[K]) InsertAll(keys ...K) error {
+	for _, key := range keys {
+		if err := s.Insert(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *HashSet[K]) Delete(key K) error {
+	return s.table.Delete(key)
+}
+
+func (s *HashSet[K]) Contains(key K) bool {
+	_, err := s.table.Find(key)
+	return err == nil
+}
+
+func (s *HashSet[K]) Size() int32 {
+	return s.table.GetSize()
+}
+
+// Range calls f for every key in no particular order, stopping when f
+// returns false. f must not add or delete keys.
+func (s *HashSet[K]) Range(f func(K) bool) {
+	s.table.Range(func(key K, _ struct{}) bool {
+		return f(key)
+	})
+}
+
+func (s *HashSet[K]) Keys() []K {
+	return s.table.Keys()
+}
+
+func (s *HashSet[K]) Union(other sets.Set[K]) (*HashSet[K], error) {
+	return s.apply(sets.Union[K], other)
+}
+
+func (s *HashSet[K]) Intersection(other sets.Set[K]) (*HashSet[K], error) {
+	return s.apply(sets.Intersection[K], other)
+}
+
+func (s *HashSet[K]) Difference(other sets.Set[K]) (*HashSet[K], error) {
+	return s.apply(sets.Difference[K], other)
+}
+
+func (s *HashSet[K]) SymmetricDifference(other sets.Set[K]) (*HashSet[K], error) {
+	return s.apply(sets.SymmetricDifference[K], other)
+}
+
+func (s *HashSet[K]) IsSubset(other sets.Set[K]) bool {
+	return sets.IsSubset[K](s, other)
+}
+
+func (s *HashSet[K]) Equal(other sets.Set[K]) bool {
+	return sets.Equal[K](s, other)
+}
+
+// apply runs op on s and other into a new set that shares s's hasher.
+func (s *HashSet[K]) apply(op func(sets.Inserter[K], sets.Set[K], sets.Set[K]) error, other sets.Set[K]) (*HashSet[K], error) {
+	out := NewHashSetWithHasher[K](0, s.hasher)
+	if err := op(out, s, other); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,97 @@
+package hashset
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/hashtables"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_HashSet(t *testing.T) {
+	is := assert.New(t)
+	s := NewHashSet[string](0)
+
+	tests := []struct {
+		name   string
+		action func()
+		want   []string
+	}{
+		{
+			name: "insert keys",
+			action: func() {
+				is.Nil(s.InsertAll("a", "b", "c"))
+			},
+			want: []string{"a", "b", "c"},
+		},
+		{
+			name: "insert an existing key",
+			action: func() {
+				is.Nil(s.Insert("a"))
+			},
+			want: []string{"a", "b", "c"},
+		},
+		{
+			name: "insert invalid key",
+			action: func() {
+				is.ErrorIs(s.Insert(""), hashtables.ErrInvalidKey)
+			},
+			want: []string{"a", "b", "c"},
+		},
+		{
+			name: "delete a key",
+			action: func() {
+				is.Nil(s.Delete("b"))
+				is.ErrorIs(s.Delete("b"), hashtables.ErrNotFound)
+			},
+			want: []string{"a", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.action()
+			keys := s.Keys()
+			sort.Strings(keys)
+			is.Equal(tt.want, keys)
+			is.EqualValues(len(tt.want), s.Size())
+			for _, key := range tt.want {
+				is.True(s.Contains(key))
+			}
+			is.False(s.Contains("z"))
+		})
+	}
+}
+
+func Test_Algebra(t *testing.T) {
+	is := assert.New(t)
+
+	a := NewHashSetWithHasher[int](0, hashtables.NewSipHasher[int](1, 2))
+	b := NewHashSet[int](0)
+	is.Nil(a.InsertAll(1, 2, 3, 4))
+	is.Nil(b.InsertAll(3, 4, 5))
+
+	keys := func(s *HashSet[int], err error) []int {
+		is.Nil(err)
+		out := s.Keys()
+		sort.Ints(out)
+		return out
+	}
+
+	is.Equal([]int{1, 2, 3, 4, 5}, keys(a.Union(b)))
+	is.Equal([]int{3, 4}, keys(a.Intersection(b)))
+	is.Equal([]int{1, 2}, keys(a.Difference(b)))
+	is.Equal([]int{1, 2, 5}, keys(a.SymmetricDifference(b)))
+
+	inter, err := a.Intersection(b)
+	is.Nil(err)
+	is.True(inter.IsSubset(a))
+	is.True(inter.IsSubset(b))
+	is.False(a.IsSubset(b))
+	is.False(a.Equal(b))
+	is.True(a.Equal(a))
+
+	// Operands are left untouched.
+	is.EqualValues(4, a.Size())
+	is.EqualValues(3, b.Size())
+}
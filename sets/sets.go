@@ -14,3 +14,17 @@ type Seter[K constraints.Ordered, V any] interface {
 	FindPrev(K) (V, error)
 	Size() int32
 }
+
+// Set is the read side of a set of keys, which is all the set algebra in
+// this package needs from its operands. hashset.HashSet and
+// sortedarray.SortedArray both implement it.
+type Set[K comparable] interface {
+	Contains(K) bool
+	Size() int32
+	Range(func(K) bool)
+}
+
+// Inserter receives the result of a set operation.
+type Inserter[K comparable] interface {
+	Insert(K) error
+}
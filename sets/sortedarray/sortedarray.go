@@ -16,6 +16,7 @@ type SortedArray[K constraints.Ordered, V any] struct {
 
 type ISortedArray[K constraints.Ordered, V any] interface {
 	sets.Seter[K, V]
+	sets.Set[K]
 	InOrder() ([]*data.Data[K, V], error)
 }
 
@@ -183,6 +184,21 @@ func (sa *SortedArray[K, V]) FindPrev(key K) (V, error) {
 	return sa.array[index-1].Value, nil
 }
 
+func (sa *SortedArray[K, V]) Contains(key K) bool {
+	_, err := sa.getIndex(key)
+	return err == nil
+}
+
+// Range calls f for every key in ascending order, stopping when f returns
+// false.
+func (sa *SortedArray[K, V]) Range(f func(K) bool) {
+	for i := int32(0); i < sa.GetLenght(); i++ {
+		if !f(sa.array[i].GetKey()) {
+			return
+		}
+	}
+}
+
 func (sa *SortedArray[K, V]) InOrder() ([]*data.Data[K, V], error) {
 	return sa.array, nil
 }
@@ -2,6 +2,7 @@ package binarytree
 
 import (
 	"fmt"
+	"iter"
 	"math"
 
 	"github.com/OladapoAjala/datastructures/sequences"
@@ -11,11 +12,14 @@ import (
 
 type BinaryTree[T comparable] struct {
 	Root *node.Node[T]
+	// version counts structural changes, for fail-fast iteration.
+	version uint64
 }
 
 type IBinaryTree[T comparable] interface {
 	trees.ITrees[T]
 	sequences.Sequencer[T]
+	sequences.Assigner[T]
 	InsertAfter(*node.Node[T], *node.Node[T]) error
 	InsertBefore(*node.Node[T], *node.Node[T]) error
 	SubTree(*node.Node[T], int32) *node.Node[T]
@@ -62,6 +66,7 @@ func (bt *BinaryTree[T]) Insert(index int32, data T) error {
 	newNode := node.NewNode[T](data)
 	if bt.Root == nil {
 		bt.Root = newNode
+		bt.version++
 		return nil
 	}
 	if index == bt.GetSize() {
@@ -69,6 +74,7 @@ func (bt *BinaryTree[T]) Insert(index int32, data T) error {
 		last.Right = newNode
 		newNode.Parent = last
 		bt.update(last)
+		bt.version++
 		return nil
 	}
 
@@ -102,6 +108,7 @@ func (bt *BinaryTree[T]) delete(n *node.Node[T]) error {
 			n.Parent.Right = nil
 		}
 		bt.update(n.Parent)
+		bt.version++
 		return nil
 	}
 
@@ -135,6 +142,21 @@ func (bt *BinaryTree[T]) Set(index int32, data T) error {
 	return nil
 }
 
+// Assign overwrites the tree's data in sequence order with values, stepping
+// from node to successor.
+func (bt *BinaryTree[T]) Assign(values iter.Seq[T]) {
+	n, _ := bt.SubTreeFirst(bt.Root)
+	for v := range values {
+		if n == nil {
+			return
+		}
+		n.Data = v
+		// The last node has no successor, which Successor reports as an
+		// error.
+		n, _ = bt.Successor(n)
+	}
+}
+
 func (bt *BinaryTree[T]) InsertFirst(data T) error {
 	return bt.Insert(0, data)
 }
@@ -198,6 +220,7 @@ func (bt *BinaryTree[T]) InsertAfter(old, new *node.Node[T]) error {
 		old.Right = new
 		new.Parent = old
 		bt.update(old)
+		bt.version++
 		return nil
 	}
 
@@ -208,6 +231,7 @@ func (bt *BinaryTree[T]) InsertAfter(old, new *node.Node[T]) error {
 	successor.Left = new
 	new.Parent = successor
 	bt.update(successor)
+	bt.version++
 	return nil
 }
 
@@ -223,6 +247,7 @@ func (bt *BinaryTree[T]) InsertBefore(old, new *node.Node[T]) error {
 		old.Left = new
 		new.Parent = old
 		bt.update(old)
+		bt.version++
 		return nil
 	}
 
@@ -233,6 +258,7 @@ func (bt *BinaryTree[T]) InsertBefore(old, new *node.Node[T]) error {
 	predecessor.Right = new
 	new.Parent = predecessor
 	bt.update(predecessor)
+	bt.version++
 	return nil
 }
 
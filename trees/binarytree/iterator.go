@@ -0,0 +1,56 @@
+package binarytree
+
+import (
+	"iter"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+	"github.com/OladapoAjala/datastructures/trees/node"
+)
+
+// Iterator walks the tree in sequence order, stepping to the successor of
+// the current node, so a full pass takes O(n). It is fail-fast: once a node
+// is inserted or deleted, Next returns false and Err reports
+// sequences.ErrConcurrentModification. Data overwritten with Set is seen by
+// the iterator.
+func (bt *BinaryTree[T]) Iterator() sequences.Iterator[T] {
+	next := func(n *node.Node[T]) *node.Node[T] {
+		if n == nil {
+			n, _ = bt.SubTreeFirst(bt.Root)
+			return n
+		}
+		// The last node has no successor, which Successor reports as an
+		// error.
+		n, _ = bt.Successor(n)
+		return n
+	}
+	return sequences.NewLinkIterator(next, nodeData[T], bt.GetSize(), &bt.version, false)
+}
+
+// ReverseIterator is Iterator stepping to predecessors from the last node.
+func (bt *BinaryTree[T]) ReverseIterator() sequences.Iterator[T] {
+	prev := func(n *node.Node[T]) *node.Node[T] {
+		if n == nil {
+			n, _ = bt.SubTreeLast(bt.Root)
+			return n
+		}
+		n, _ = bt.Predecessor(n)
+		return n
+	}
+	return sequences.NewLinkIterator(prev, nodeData[T], bt.GetSize(), &bt.version, true)
+}
+
+func nodeData[T comparable](n *node.Node[T]) T {
+	return n.Data
+}
+
+func (bt *BinaryTree[T]) All() iter.Seq2[int32, T] {
+	return sequences.All(bt.Iterator)
+}
+
+func (bt *BinaryTree[T]) Backward() iter.Seq2[int32, T] {
+	return sequences.All(bt.ReverseIterator)
+}
+
+func (bt *BinaryTree[T]) Values() iter.Seq[T] {
+	return sequences.Values(bt.Iterator)
+}
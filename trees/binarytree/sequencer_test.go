@@ -0,0 +1,14 @@
+package binarytree
+
+import (
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+	"github.com/OladapoAjala/datastructures/sequences/sequencetest"
+)
+
+func Test_Sequencer(t *testing.T) {
+	sequencetest.Run(t, func() sequences.Sequencer[int] {
+		return NewBinaryTree[int]()
+	})
+}
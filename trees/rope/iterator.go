@@ -0,0 +1,152 @@
+package rope
+
+import (
+	"iter"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+)
+
+// Iterator walks the leaves of the rope with a stack of the subtrees still
+// to visit, so a full pass takes O(n). It is fail-fast: once an edit other
+// than Set changes the rope, Next returns false and Err reports
+// sequences.ErrConcurrentModification. Set swaps in a copy of the path to
+// one leaf, so after a Set the iterator finds its place again in the new
+// tree, in O(log n), and sees the new rune.
+type Iterator struct {
+	rope     *Rope
+	version  uint64
+	root     *node
+	stack    []*node
+	leaf     []rune
+	offset   int
+	index    int32
+	forward  bool
+	started  bool
+	finished bool
+	err      error
+}
+
+var _ sequences.Iterator[rune] = new(Iterator)
+
+func (r *Rope) Iterator() sequences.Iterator[rune] {
+	return &Iterator{rope: r, version: r.version, index: -1, forward: true}
+}
+
+func (r *Rope) ReverseIterator() sequences.Iterator[rune] {
+	return &Iterator{rope: r, version: r.version, index: r.GetSize()}
+}
+
+func (it *Iterator) Next() bool {
+	if it.err != nil || it.finished {
+		return false
+	}
+	if it.version != it.rope.version {
+		it.leaf = nil
+		it.err = sequences.ErrConcurrentModification
+		return false
+	}
+
+	if !it.started {
+		it.started = true
+		it.root = it.rope.root
+		it.descend(it.root)
+	} else {
+		if it.root != it.rope.root {
+			it.root = it.rope.root
+			it.seek(it.index)
+		}
+		if it.forward {
+			it.offset++
+		} else {
+			it.offset--
+		}
+	}
+	for it.offset < 0 || it.offset >= len(it.leaf) {
+		if len(it.stack) == 0 {
+			it.leaf = nil
+			it.finished = true
+			return false
+		}
+		n := it.stack[len(it.stack)-1]
+		it.stack = it.stack[:len(it.stack)-1]
+		it.descend(n)
+	}
+
+	if it.forward {
+		it.index++
+	} else {
+		it.index--
+	}
+	return true
+}
+
+// descend goes down to the first leaf of n in the direction of travel,
+// stacking the subtrees it passes over.
+func (it *Iterator) descend(n *node) {
+	for n != nil && !n.isLeaf() {
+		if it.forward {
+			it.stack = append(it.stack, n.right)
+			n = n.left
+		} else {
+			it.stack = append(it.stack, n.left)
+			n = n.right
+		}
+	}
+
+	it.leaf, it.offset = nil, 0
+	if n != nil {
+		it.leaf = n.leaf
+	}
+	if !it.forward {
+		it.offset = len(it.leaf) - 1
+	}
+}
+
+// seek rebuilds the stack for the rune at i, stacking the subtrees on the
+// way down that are still to visit in the direction of travel.
+func (it *Iterator) seek(i int32) {
+	it.stack = it.stack[:0]
+	n := it.root
+	for !n.isLeaf() {
+		if i < n.left.size {
+			if it.forward {
+				it.stack = append(it.stack, n.right)
+			}
+			n = n.left
+		} else {
+			if !it.forward {
+				it.stack = append(it.stack, n.left)
+			}
+			i -= n.left.size
+			n = n.right
+		}
+	}
+	it.leaf, it.offset = n.leaf, int(i)
+}
+
+func (it *Iterator) Index() int32 {
+	return it.index
+}
+
+func (it *Iterator) Value() rune {
+	if it.leaf == nil {
+		return 0
+	}
+	return it.leaf[it.offset]
+}
+
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+func (r *Rope) All() iter.Seq2[int32, rune] {
+	return sequences.All(r.Iterator)
+}
+
+func (r *Rope) Backward() iter.Seq2[int32, rune] {
+	return sequences.All(r.ReverseIterator)
+}
+
+func (r *Rope) Values() iter.Seq[rune] {
+	return sequences.Values(r.Iterator)
+}
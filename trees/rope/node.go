@@ -0,0 +1,210 @@
+package rope
+
+import "slices"
+
+// LEAF_SIZE is the most runes a leaf holds. Joining neighbouring leaves
+// that fit together in one merges them, so typing a character at a time
+// does not leave a leaf per character.
+const LEAF_SIZE = 256
+
+// node is a leaf holding a chunk of text or an internal node holding the
+// concatenation of its two children. Like the nodes of trees/binarytree it
+// is augmented with its subtree's size and height, and also with the
+// number of newlines, which makes index and line lookups O(log n). It is
+// not that node type itself, which holds one element per node and is
+// changed in place: nodes here are never modified once built, so ropes can
+// share them.
+type node struct {
+	left   *node
+	right  *node
+	leaf   []rune
+	size   int32
+	lines  int32
+	height int32
+}
+
+func newLeaf(runes []rune) *node {
+	if len(runes) == 0 {
+		return nil
+	}
+	n := &node{leaf: runes, size: int32(len(runes))}
+	for _, r := range runes {
+		if r == '\n' {
+			n.lines++
+		}
+	}
+	return n
+}
+
+func newInternal(left, right *node) *node {
+	return &node{
+		left:   left,
+		right:  right,
+		size:   left.size + right.size,
+		lines:  left.lines + right.lines,
+		height: 1 + max(left.height, right.height),
+	}
+}
+
+func (n *node) isLeaf() bool {
+	return n.left == nil
+}
+
+// build makes a perfectly balanced tree from runes.
+func build(runes []rune) *node {
+	if len(runes) <= LEAF_SIZE {
+		return newLeaf(runes)
+	}
+	leaves := (len(runes) + LEAF_SIZE - 1) / LEAF_SIZE
+	mid := leaves / 2 * LEAF_SIZE
+	return newInternal(build(runes[:mid:mid]), build(runes[mid:]))
+}
+
+// join concatenates l and r. When their heights differ by more than one it
+// descends the taller tree's inner spine until they do not, and rebalances
+// on the way back up, as an AVL insert would; this takes O(|h(l)-h(r)|).
+// A small leaf is carried down to merge with its neighbour.
+func join(l, r *node) *node {
+	switch {
+	case l == nil:
+		return r
+	case r == nil:
+		return l
+	case l.isLeaf() && r.isLeaf() && l.size+r.size <= LEAF_SIZE:
+		runes := make([]rune, 0, l.size+r.size)
+		return newLeaf(append(append(runes, l.leaf...), r.leaf...))
+	case l.height > r.height+1 || !l.isLeaf() && r.isLeaf() && r.size < LEAF_SIZE/2:
+		return balance(l.left, join(l.right, r))
+	case r.height > l.height+1 || !r.isLeaf() && l.isLeaf() && l.size < LEAF_SIZE/2:
+		return balance(join(l, r.left), r.right)
+	}
+	return newInternal(l, r)
+}
+
+// balance joins two AVL trees whose heights differ by at most two with a
+// single or double rotation.
+func balance(l, r *node) *node {
+	switch {
+	case l.height > r.height+1:
+		if l.left.height >= l.right.height {
+			return newInternal(l.left, newInternal(l.right, r))
+		}
+		return newInternal(newInternal(l.left, l.right.left), newInternal(l.right.right, r))
+	case r.height > l.height+1:
+		if r.right.height >= r.left.height {
+			return newInternal(newInternal(l, r.left), r.right)
+		}
+		return newInternal(newInternal(l, r.left.left), newInternal(r.left.right, r.right))
+	}
+	return newInternal(l, r)
+}
+
+// split returns the first i runes of n and the rest.
+func split(n *node, i int32) (*node, *node) {
+	switch {
+	case n == nil || i <= 0:
+		return nil, n
+	case i >= n.size:
+		return n, nil
+	case n.isLeaf():
+		return newLeaf(n.leaf[:i:i]), newLeaf(n.leaf[i:])
+	case i < n.left.size:
+		a, b := split(n.left, i)
+		return a, join(b, n.right)
+	case i == n.left.size:
+		return n.left, n.right
+	}
+	a, b := split(n.right, i-n.left.size)
+	return join(n.left, a), b
+}
+
+// index returns the rune at i, which must be in range.
+func (n *node) index(i int32) rune {
+	for !n.isLeaf() {
+		if i < n.left.size {
+			n = n.left
+		} else {
+			i -= n.left.size
+			n = n.right
+		}
+	}
+	return n.leaf[i]
+}
+
+// set returns a copy of n with the rune at i, which must be in range,
+// replaced by r. Only the path down to the leaf is copied, so n is
+// unchanged and the copy has the same shape.
+func (n *node) set(i int32, r rune) *node {
+	if n.isLeaf() {
+		leaf := slices.Clone(n.leaf)
+		leaf[i] = r
+		return newLeaf(leaf)
+	}
+	if i < n.left.size {
+		return newInternal(n.left.set(i, r), n.right)
+	}
+	return newInternal(n.left, n.right.set(i-n.left.size, r))
+}
+
+// appendRange appends the runes from i up to j to out.
+func (n *node) appendRange(out []rune, i, j int32) []rune {
+	if n == nil || i >= j {
+		return out
+	}
+	if n.isLeaf() {
+		return append(out, n.leaf[i:j]...)
+	}
+	if i < n.left.size {
+		out = n.left.appendRange(out, i, min(j, n.left.size))
+	}
+	if j > n.left.size {
+		out = n.right.appendRange(out, max(i-n.left.size, 0), j-n.left.size)
+	}
+	return out
+}
+
+// newlines counts the newlines among the first i runes.
+func (n *node) newlines(i int32) int32 {
+	var count int32
+	for n != nil && i > 0 {
+		if n.isLeaf() {
+			for _, r := range n.leaf[:i] {
+				if r == '\n' {
+					count++
+				}
+			}
+			break
+		}
+		if i < n.left.size {
+			n = n.left
+		} else {
+			count += n.left.lines
+			i -= n.left.size
+			n = n.right
+		}
+	}
+	return count
+}
+
+// newline returns the index of the k-th newline, counting from 1, which
+// must exist.
+func (n *node) newline(k int32) int32 {
+	var offset int32
+	for !n.isLeaf() {
+		if k <= n.left.lines {
+			n = n.left
+		} else {
+			k -= n.left.lines
+			offset += n.left.size
+			n = n.right
+		}
+	}
+	for i, r := range n.leaf {
+		if r == '\n' {
+			if k--; k == 0 {
+				return offset + int32(i)
+			}
+		}
+	}
+	return -1
+}
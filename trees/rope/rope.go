@@ -0,0 +1,231 @@
+package rope
+
+import (
+	"fmt"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+)
+
+// Rope is a text sequence stored as a balanced tree of rune chunks, for
+// editing large strings: inserting, deleting, indexing, splitting and
+// concatenating take O(log n) instead of the O(n) of a flat array. Indices
+// count runes, not bytes, and lines and columns count from 0.
+//
+// Concat and Split leave their receiver unchanged and share its nodes with
+// the ropes they return. The other edits change the receiver.
+type Rope struct {
+	root *node
+	// version counts structural changes, for fail-fast iteration.
+	version uint64
+}
+
+type IRope interface {
+	sequences.Sequencer[rune]
+	fmt.Stringer
+	Concat(*Rope) *Rope
+	Split(int32) (*Rope, *Rope, error)
+	Substring(int32, int32) (string, error)
+	InsertString(int32, string) error
+	DeleteRange(int32, int32) error
+	LineColumn(int32) (int32, int32, error)
+	Offset(int32, int32) (int32, error)
+	Line(int32) (string, error)
+	GetLineCount() int32
+}
+
+var _ IRope = new(Rope)
+
+func NewRope(s string) *Rope {
+	return &Rope{root: build([]rune(s))}
+}
+
+func (r *Rope) String() string {
+	if r.root == nil {
+		return ""
+	}
+	return string(r.root.appendRange(make([]rune, 0, r.root.size), 0, r.root.size))
+}
+
+// Concat returns a rope holding r followed by other.
+func (r *Rope) Concat(other *Rope) *Rope {
+	return &Rope{root: join(r.root, other.root)}
+}
+
+// Split returns ropes holding the first i runes of r and the rest.
+func (r *Rope) Split(i int32) (*Rope, *Rope, error) {
+	if i < 0 || i > r.GetSize() {
+		return nil, nil, fmt.Errorf("index out of range")
+	}
+	a, b := split(r.root, i)
+	return &Rope{root: a}, &Rope{root: b}, nil
+}
+
+// Substring returns the runes from index i up to, but not including, j.
+func (r *Rope) Substring(i, j int32) (string, error) {
+	if i < 0 || j > r.GetSize() || i > j {
+		return "", fmt.Errorf("invalid range [%d, %d)", i, j)
+	}
+	return string(r.root.appendRange(make([]rune, 0, j-i), i, j)), nil
+}
+
+// InsertString puts s at index i, which may be GetSize to append.
+func (r *Rope) InsertString(i int32, s string) error {
+	if i < 0 || i > r.GetSize() {
+		return fmt.Errorf("index out of range")
+	}
+	if s == "" {
+		return nil
+	}
+	a, b := split(r.root, i)
+	r.root = join(join(a, build([]rune(s))), b)
+	r.version++
+	return nil
+}
+
+// DeleteRange removes the runes from index i up to, but not including, j.
+func (r *Rope) DeleteRange(i, j int32) error {
+	if i < 0 || j > r.GetSize() || i > j {
+		return fmt.Errorf("invalid range [%d, %d)", i, j)
+	}
+	if i == j {
+		return nil
+	}
+	a, rest := split(r.root, i)
+	_, b := split(rest, j-i)
+	r.root = join(a, b)
+	r.version++
+	return nil
+}
+
+func (r *Rope) GetData(index int32) (rune, error) {
+	if index < 0 || index >= r.GetSize() {
+		return 0, fmt.Errorf("index out of range")
+	}
+	return r.root.index(index), nil
+}
+
+func (r *Rope) Contains(data rune) bool {
+	for v := range r.Values() {
+		if v == data {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rope) Insert(index int32, data rune) error {
+	return r.InsertString(index, string(data))
+}
+
+// Set replaces the rune at index. The nodes may be shared, so it copies the
+// path to the rune's leaf instead of changing it in place, but the tree
+// keeps its shape and, as in the other sequences, Set is not a structural
+// change.
+func (r *Rope) Set(index int32, data rune) error {
+	if index < 0 || index >= r.GetSize() {
+		return fmt.Errorf("index out of range")
+	}
+	r.root = r.root.set(index, data)
+	return nil
+}
+
+func (r *Rope) InsertFirst(data rune) error {
+	return r.Insert(0, data)
+}
+
+func (r *Rope) InsertLast(data rune) error {
+	return r.Insert(r.GetSize(), data)
+}
+
+func (r *Rope) Delete(index int32) error {
+	if r.IsEmpty() {
+		return fmt.Errorf("cannot remove from empty rope")
+	}
+	if index < 0 || index >= r.GetSize() {
+		return fmt.Errorf("index out of range")
+	}
+	return r.DeleteRange(index, index+1)
+}
+
+func (r *Rope) DeleteFirst() error {
+	return r.Delete(0)
+}
+
+func (r *Rope) DeleteLast() error {
+	return r.Delete(r.GetSize() - 1)
+}
+
+// LineColumn returns the line and column of index, which may be GetSize to
+// locate the end of the text.
+func (r *Rope) LineColumn(index int32) (int32, int32, error) {
+	if index < 0 || index > r.GetSize() {
+		return 0, 0, fmt.Errorf("index out of range")
+	}
+	line := r.root.newlines(index)
+	return line, index - r.lineStart(line), nil
+}
+
+// Offset returns the index of column in line. The column may be the
+// line's length, which is the index of its newline.
+func (r *Rope) Offset(line, column int32) (int32, error) {
+	if line < 0 || line >= r.GetLineCount() {
+		return 0, fmt.Errorf("line %d out of range", line)
+	}
+	start, end := r.lineStart(line), r.lineEnd(line)
+	if column < 0 || column > end-start {
+		return 0, fmt.Errorf("column %d out of range", column)
+	}
+	return start + column, nil
+}
+
+// Line returns the text of line, without its newline.
+func (r *Rope) Line(line int32) (string, error) {
+	if line < 0 || line >= r.GetLineCount() {
+		return "", fmt.Errorf("line %d out of range", line)
+	}
+	return r.Substring(r.lineStart(line), r.lineEnd(line))
+}
+
+// lineStart is the index of the first rune of line.
+func (r *Rope) lineStart(line int32) int32 {
+	if line == 0 {
+		return 0
+	}
+	return r.root.newline(line) + 1
+}
+
+// lineEnd is the index of the newline ending line, or GetSize for the last
+// line.
+func (r *Rope) lineEnd(line int32) int32 {
+	if line == r.GetLineCount()-1 {
+		return r.GetSize()
+	}
+	return r.root.newline(line + 1)
+}
+
+// GetLineCount returns the number of newlines plus one, so empty text has
+// one empty line.
+func (r *Rope) GetLineCount() int32 {
+	if r.root == nil {
+		return 1
+	}
+	return r.root.lines + 1
+}
+
+func (r *Rope) GetSize() int32 {
+	if r.root == nil {
+		return 0
+	}
+	return r.root.size
+}
+
+func (r *Rope) GetHeight() int32 {
+	if r.root == nil {
+		return 0
+	}
+	return r.root.height
+}
+
+func (r *Rope) IsEmpty() bool {
+	return r.root == nil
+}
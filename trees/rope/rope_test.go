@@ -0,0 +1,286 @@
+package rope
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/OladapoAjala/datastructures/sequences"
+	"github.com/stretchr/testify/assert"
+)
+
+// check verifies the augmentation and AVL balance of every node and
+// returns the number of leaves.
+func check(is *assert.Assertions, n *node) int {
+	if n == nil {
+		return 0
+	}
+	if n.isLeaf() {
+		is.NotEmpty(n.leaf)
+		is.LessOrEqual(len(n.leaf), LEAF_SIZE)
+		is.EqualValues(len(n.leaf), n.size)
+		is.EqualValues(strings.Count(string(n.leaf), "\n"), n.lines)
+		is.Zero(n.height)
+		return 1
+	}
+
+	is.NotNil(n.right)
+	is.Equal(n.left.size+n.right.size, n.size)
+	is.Equal(n.left.lines+n.right.lines, n.lines)
+	is.Equal(1+max(n.left.height, n.right.height), n.height)
+	is.LessOrEqual(n.left.height-n.right.height, int32(1))
+	is.LessOrEqual(n.right.height-n.left.height, int32(1))
+	return check(is, n.left) + check(is, n.right)
+}
+
+func Test_Rope(t *testing.T) {
+	is := assert.New(t)
+	long := strings.Repeat("0123456789", 100)
+
+	tests := []struct {
+		name string
+		want func()
+	}{
+		{
+			name: "string round trip",
+			want: func() {
+				for _, s := range []string{"", "héllo, wörld", long} {
+					r := NewRope(s)
+					is.Equal(s, r.String())
+					is.EqualValues(len([]rune(s)), r.GetSize())
+					check(is, r.root)
+				}
+				is.True(NewRope("").IsEmpty())
+			},
+		},
+		{
+			name: "index and substring",
+			want: func() {
+				r := NewRope(long)
+				v, err := r.GetData(537)
+				is.Nil(err)
+				is.Equal('7', v)
+				_, err = r.GetData(1000)
+				is.Error(err)
+
+				s, err := r.Substring(250, 265)
+				is.Nil(err)
+				is.Equal(long[250:265], s)
+				s, err = r.Substring(10, 10)
+				is.Nil(err)
+				is.Equal("", s)
+				_, err = r.Substring(5, 1001)
+				is.Error(err)
+			},
+		},
+		{
+			name: "insert and delete",
+			want: func() {
+				r := NewRope("hello world")
+				is.Nil(r.InsertString(5, ","))
+				is.Nil(r.InsertString(r.GetSize(), "!"))
+				is.Nil(r.InsertString(0, "¡"))
+				is.Equal("¡hello, world!", r.String())
+
+				is.Nil(r.DeleteRange(1, 8))
+				is.Equal("¡world!", r.String())
+				is.Nil(r.Set(0, 'W'))
+				is.Nil(r.Delete(6))
+				is.Equal("Wworld", r.String())
+				is.Error(r.DeleteRange(3, 2))
+				is.Error(r.InsertString(7, "x"))
+			},
+		},
+		{
+			name: "concat and split leave the receiver unchanged",
+			want: func() {
+				a, b := NewRope(long), NewRope("tail")
+				c := a.Concat(b)
+				is.Equal(long+"tail", c.String())
+				is.Equal(long, a.String())
+				check(is, c.root)
+
+				left, right, err := c.Split(503)
+				is.Nil(err)
+				is.Equal(long[:503], left.String())
+				is.Equal(long[503:]+"tail", right.String())
+				is.Equal(long+"tail", c.String())
+				check(is, left.root)
+				check(is, right.root)
+
+				_, _, err = c.Split(-1)
+				is.Error(err)
+			},
+		},
+		{
+			name: "concat of uneven heights stays balanced",
+			want: func() {
+				r := NewRope("")
+				for i := 0; i < 200; i++ {
+					r = r.Concat(NewRope(long[:i%50+1]))
+					r = NewRope(strings.Repeat("x", i%7)).Concat(r)
+				}
+				check(is, r.root)
+				is.LessOrEqual(r.GetHeight(), int32(10))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want()
+		})
+	}
+}
+
+func Test_Lines(t *testing.T) {
+	is := assert.New(t)
+	r := NewRope("ab\ncd\n\nefg")
+
+	is.EqualValues(4, r.GetLineCount())
+	is.EqualValues(1, NewRope("").GetLineCount())
+
+	tests := []struct {
+		index, line, column int32
+	}{
+		{0, 0, 0},
+		{2, 0, 2},
+		{3, 1, 0},
+		{5, 1, 2},
+		{6, 2, 0},
+		{7, 3, 0},
+		{10, 3, 3},
+	}
+	for _, tt := range tests {
+		line, column, err := r.LineColumn(tt.index)
+		is.Nil(err)
+		is.Equal(tt.line, line, "index %d", tt.index)
+		is.Equal(tt.column, column, "index %d", tt.index)
+
+		offset, err := r.Offset(tt.line, tt.column)
+		is.Nil(err)
+		is.Equal(tt.index, offset)
+	}
+
+	for i, want := range []string{"ab", "cd", "", "efg"} {
+		line, err := r.Line(int32(i))
+		is.Nil(err)
+		is.Equal(want, line)
+	}
+
+	_, _, err := r.LineColumn(11)
+	is.Error(err)
+	_, err = r.Offset(1, 3)
+	is.Error(err)
+	_, err = r.Offset(4, 0)
+	is.Error(err)
+	_, err = r.Line(-1)
+	is.Error(err)
+}
+
+func Test_Iterator(t *testing.T) {
+	is := assert.New(t)
+	text := strings.Repeat("abcdefghij", 60)
+	r := NewRope(text)
+
+	var forward []rune
+	for i, v := range r.All() {
+		is.Equal(rune(text[i]), v)
+		forward = append(forward, v)
+	}
+	is.Equal(text, string(forward))
+
+	var backward []rune
+	for i, v := range r.Backward() {
+		is.Equal(rune(text[i]), v)
+		backward = append(backward, v)
+	}
+	slices.Reverse(backward)
+	is.Equal(text, string(backward))
+
+	for range NewRope("").All() {
+		is.Fail("empty rope yielded")
+	}
+
+	// Set is seen, in the current leaf and in later ones, and leaves a rope
+	// sharing the nodes unchanged.
+	shared := r.Concat(NewRope(""))
+	want := []rune(text)
+	var got []rune
+	for i, v := range r.All() {
+		if i%100 == 0 && i+LEAF_SIZE < r.GetSize() {
+			is.Nil(r.Set(i+1, 'X'))
+			is.Nil(r.Set(i+LEAF_SIZE, 'Y'))
+			want[i+1], want[i+LEAF_SIZE] = 'X', 'Y'
+		}
+		got = append(got, v)
+	}
+	is.Equal(string(want), string(got))
+	is.Equal(string(want), r.String())
+	is.Equal(text, shared.String())
+
+	got = nil
+	for i, v := range r.Backward() {
+		if i == 550 {
+			is.Nil(r.Set(549, 'x'))
+			is.Nil(r.Set(10, 'y'))
+			want[549], want[10] = 'x', 'y'
+		}
+		got = append(got, v)
+	}
+	slices.Reverse(got)
+	is.Equal(string(want), string(got))
+
+	it := r.Iterator()
+	is.True(it.Next())
+	is.Nil(r.DeleteFirst())
+	is.False(it.Next())
+	is.ErrorIs(it.Err(), sequences.ErrConcurrentModification)
+}
+
+// Test_Random checks edits against a rune slice and the tree invariants.
+func Test_Random(t *testing.T) {
+	is := assert.New(t)
+	rng := rand.New(rand.NewSource(50))
+	r := NewRope("")
+	var model []rune
+
+	for i := 0; i < 3000; i++ {
+		at := int32(rng.Intn(len(model) + 1))
+		switch op := rng.Intn(10); {
+		case op < 6:
+			// Mostly single characters, as typed.
+			s := string(rune('a' + rng.Intn(26)))
+			if op == 0 {
+				s = strings.Repeat("line\n", rng.Intn(100))
+			}
+			is.Nil(r.InsertString(at, s))
+			model = slices.Insert(model, int(at), []rune(s)...)
+		case op < 8 && len(model) > 0:
+			j := min(at+int32(rng.Intn(20)), int32(len(model)))
+			is.Nil(r.DeleteRange(at, j))
+			model = slices.Delete(model, int(at), int(j))
+		case op == 8:
+			left, right, err := r.Split(at)
+			is.Nil(err)
+			r = left.Concat(right)
+		case len(model) > 0:
+			j := int32(rng.Intn(len(model)))
+			is.Nil(r.Set(j, 'Z'))
+			model[j] = 'Z'
+		}
+	}
+
+	is.Equal(string(model), r.String())
+	leaves := check(is, r.root)
+	is.LessOrEqual(leaves, 4*len(model)/LEAF_SIZE+2)
+
+	lines := strings.Split(string(model), "\n")
+	is.EqualValues(len(lines), r.GetLineCount())
+	for i := range lines {
+		line, err := r.Line(int32(i))
+		is.Nil(err)
+		is.Equal(lines[i], line)
+	}
+}